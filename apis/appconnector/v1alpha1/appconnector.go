@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomAppConnectorParameters that are not part of the ZPA API
+type CustomAppConnectorParameters struct {
+	// AppConnectorGroupIDRef is a reference to a AppConnectorGroupID so set external ID
+	// +optional
+	AppConnectorGroupIDRef *xpv1.Reference `json:"appConnectorGroupIDRef,omitempty"`
+
+	// AppConnectorGroupIDSelector selects a reference to a AppConnectorGroupID so set external ID
+	// +optional
+	AppConnectorGroupIDSelector *xpv1.Selector `json:"appConnectorGroupIDSelector,omitempty"`
+}
+
+// A AppConnectorParameters defines desired state of a AppConnector
+type AppConnectorParameters struct {
+	CustomAppConnectorParameters `json:",inline"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// app connector group id
+	AppConnectorGroupID *string `json:"appConnectorGroupID,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A AppConnectorSpec defines the desired state of a AppConnector.
+type AppConnectorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppConnectorParameters `json:"forProvider"`
+}
+
+// A AppConnectorStatus represents the status of a AppConnector.
+type AppConnectorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a AppConnector.
+type Observation struct {
+	CreationTime             string `json:"creationTime,omitempty"`
+	ModifiedBy               string `json:"modifiedBy,omitempty"`
+	ModifiedTime             string `json:"modifiedTime,omitempty"`
+	ID                       string `json:"id,omitempty"`
+	AppConnectorGroupName    string `json:"appConnectorGroupName,omitempty"`
+	ControlChannelStatus     string `json:"controlChannelStatus,omitempty"`
+	CtrlBrokerName           string `json:"ctrlBrokerName,omitempty"`
+	CurrentVersion           string `json:"currentVersion,omitempty"`
+	ExpectedVersion          string `json:"expectedVersion,omitempty"`
+	UpgradeStatus            string `json:"upgradeStatus,omitempty"`
+	LastBrokerConnectTime    string `json:"lastBrokerConnectTime,omitempty"`
+	LastBrokerDisconnectTime string `json:"lastBrokerDisconnectTime,omitempty"`
+	Platform                 string `json:"platform,omitempty"`
+	PrivateIP                string `json:"privateIP,omitempty"`
+	PublicIP                 string `json:"publicIP,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A AppConnector is the schema for ZPA AppConnectors API. App Connectors
+// enroll themselves using a provisioning key, so an existing App Connector is
+// adopted by setting the external name annotation to its ID. Deleting an
+// AppConnector deletes the App Connector from ZPA, unless its deletion policy
+// is Orphan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.controlChannelStatus"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.currentVersion",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type AppConnector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppConnectorSpec   `json:"spec"`
+	Status AppConnectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppConnectorList contains a list of AppConnector
+type AppConnectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppConnector `json:"items"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	appConnectorGroup "github.com/crossplane-contrib/provider-zpa/apis/appconnectorgroup/v1alpha1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this AppConnector
+func (mg *AppConnector) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.appConnectorGroupID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AppConnectorGroupID),
+		Reference:    mg.Spec.ForProvider.AppConnectorGroupIDRef,
+		Selector:     mg.Spec.ForProvider.AppConnectorGroupIDSelector,
+		To:           reference.To{Managed: &appConnectorGroup.AppConnectorGroup{}, List: &appConnectorGroup.AppConnectorGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.appConnectorGroupID")
+	}
+	mg.Spec.ForProvider.AppConnectorGroupID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AppConnectorGroupIDRef = rsp.ResolvedReference
+
+	return nil
+}
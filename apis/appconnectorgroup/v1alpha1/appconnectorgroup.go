@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A AppConnectorGroupParameters defines desired state of a AppConnectorGroup
+type AppConnectorGroupParameters struct {
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// city and country of the location, e.g. San Jose, US
+	CityCountry string `json:"cityCountry,omitempty"`
+
+	// country code of the location, e.g. US
+	CountryCode string `json:"countryCode,omitempty"`
+
+	// location, e.g. San Jose, CA, USA
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// latitude of the location
+	// +kubebuilder:validation:Required
+	Latitude string `json:"latitude"`
+
+	// longitude of the location
+	// +kubebuilder:validation:Required
+	Longitude string `json:"longitude"`
+
+	// version profile id, 0 is Default, 1 is Previous Default and 2 is New
+	// Release
+	VersionProfileID string `json:"versionProfileID,omitempty"`
+
+	// override version profile
+	OverrideVersionProfile *bool `json:"overrideVersionProfile,omitempty"`
+
+	// upgrade day
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	UpgradeDay string `json:"upgradeDay,omitempty"`
+
+	// upgrade time in secs after midnight
+	UpgradeTimeInSecs string `json:"upgradeTimeInSecs,omitempty"`
+
+	// dns query type
+	// +kubebuilder:validation:Enum=IPV4_IPV6;IPV4;IPV6
+	DNSQueryType string `json:"dnsQueryType,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A AppConnectorGroupSpec defines the desired state of a AppConnectorGroup.
+type AppConnectorGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppConnectorGroupParameters `json:"forProvider"`
+}
+
+// A AppConnectorGroupStatus represents the status of a AppConnectorGroup.
+type AppConnectorGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a AppConnectorGroup.
+type Observation struct {
+	CreationTime       string `json:"creationTime,omitempty"`
+	ModifiedBy         string `json:"modifiedBy,omitempty"`
+	ModifiedTime       string `json:"modifiedTime,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	VersionProfileName string `json:"versionProfileName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A AppConnectorGroup is the schema for ZPA AppConnectorGroups API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type AppConnectorGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppConnectorGroupSpec   `json:"spec"`
+	Status AppConnectorGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppConnectorGroupList contains a list of AppConnectorGroup
+type AppConnectorGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppConnectorGroup `json:"items"`
+}
@@ -34,6 +34,11 @@ type CustomApplicationSegmentParameters struct {
 type ApplicationSegmentParameters struct {
 	CustomApplicationSegmentParameters `json:",inline"`
 
+	// ClientlessApps make domains of the application segment accessible in a
+	// browser, without the Zscaler Client Connector.
+	// +optional
+	ClientlessApps []ClientlessApp `json:"clientlessApps,omitempty"`
+
 	// bypass type
 	// +kubebuilder:validation:Enum=ALWAYS;NEVER;ON_NET
 	BypassType string `json:"bypassType,omitempty"`
@@ -95,6 +100,55 @@ type ApplicationSegmentParameters struct {
 	CustomerID string `json:"customerID"`
 }
 
+// CustomClientlessAppParameters that are not part of the ZPA API
+type CustomClientlessAppParameters struct {
+	// CertificateIDRef is a reference to a BACertificate so set external ID
+	// +optional
+	CertificateIDRef *xpv1.Reference `json:"certificateIDRef,omitempty"`
+
+	// CertificateIDSelector selects a reference to a BACertificate so set external ID
+	// +optional
+	CertificateIDSelector *xpv1.Selector `json:"certificateIDSelector,omitempty"`
+}
+
+// A ClientlessApp is a browser access application of an ApplicationSegment.
+type ClientlessApp struct {
+	CustomClientlessAppParameters `json:",inline"`
+
+	// name
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Domain the application is accessed at. It must be one of the domain
+	// names of the application segment.
+	// +kubebuilder:validation:Required
+	Domain string `json:"domain"`
+
+	// application port
+	// +kubebuilder:validation:Required
+	ApplicationPort string `json:"applicationPort"`
+
+	// application protocol
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	ApplicationProtocol string `json:"applicationProtocol"`
+
+	// certificate Id of a BACertificate
+	CertificateID *string `json:"certificateID,omitempty"`
+
+	// allow options
+	AllowOptions *bool `json:"allowOptions,omitempty"`
+
+	// trust untrusted cert
+	TrustUntrustedCert *bool `json:"trustUntrustedCert,omitempty"`
+}
+
 // A ApplicationSegmentSpec defines the desired state of a ApplicationSegment.
 type ApplicationSegmentSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -113,6 +167,17 @@ type Observation struct {
 	ModifiedBy   string `json:"modifiedBy,omitempty"`
 	ModifiedTime string `json:"modifiedTime,omitempty"`
 	ID           string `json:"id,omitempty"`
+
+	ClientlessApps []ClientlessAppObservation `json:"clientlessApps,omitempty"`
+}
+
+// ClientlessAppObservation are the observable fields of a ClientlessApp.
+type ClientlessAppObservation struct {
+	ID              string `json:"id,omitempty"`
+	AppID           string `json:"appID,omitempty"`
+	Domain          string `json:"domain,omitempty"`
+	Cname           string `json:"cname,omitempty"`
+	CertificateName string `json:"certificateName,omitempty"`
 }
 
 // +kubebuilder:object:root=true
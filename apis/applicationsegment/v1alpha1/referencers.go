@@ -15,7 +15,9 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
+	baCertificate "github.com/crossplane-contrib/provider-zpa/apis/bacertificate/v1alpha1"
 	segmentGroup "github.com/crossplane-contrib/provider-zpa/apis/segmentgroup/v1alpha1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
@@ -41,5 +43,22 @@ func (mg *ApplicationSegment) ResolveReferences(ctx context.Context, c client.Re
 	mg.Spec.ForProvider.SegmentGroupID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SegmentGroupIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.clientlessApps[*].certificateID
+	for i := range mg.Spec.ForProvider.ClientlessApps {
+		app := &mg.Spec.ForProvider.ClientlessApps[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(app.CertificateID),
+			Reference:    app.CertificateIDRef,
+			Selector:     app.CertificateIDSelector,
+			To:           reference.To{Managed: &baCertificate.BACertificate{}, List: &baCertificate.BACertificateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.clientlessApps[%d].certificateID", i))
+		}
+		app.CertificateID = reference.ToPtrValue(rsp.ResolvedValue)
+		app.CertificateIDRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
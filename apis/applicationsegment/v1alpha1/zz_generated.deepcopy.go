@@ -88,6 +88,13 @@ func (in *ApplicationSegmentList) DeepCopyObject() runtime.Object {
 func (in *ApplicationSegmentParameters) DeepCopyInto(out *ApplicationSegmentParameters) {
 	*out = *in
 	in.CustomApplicationSegmentParameters.DeepCopyInto(&out.CustomApplicationSegmentParameters)
+	if in.ClientlessApps != nil {
+		in, out := &in.ClientlessApps, &out.ClientlessApps
+		*out = make([]ClientlessApp, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DomainNames != nil {
 		in, out := &in.DomainNames, &out.DomainNames
 		*out = make([]string, len(*in))
@@ -166,7 +173,7 @@ func (in *ApplicationSegmentSpec) DeepCopy() *ApplicationSegmentSpec {
 func (in *ApplicationSegmentStatus) DeepCopyInto(out *ApplicationSegmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSegmentStatus.
@@ -179,6 +186,57 @@ func (in *ApplicationSegmentStatus) DeepCopy() *ApplicationSegmentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientlessApp) DeepCopyInto(out *ClientlessApp) {
+	*out = *in
+	in.CustomClientlessAppParameters.DeepCopyInto(&out.CustomClientlessAppParameters)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertificateID != nil {
+		in, out := &in.CertificateID, &out.CertificateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowOptions != nil {
+		in, out := &in.AllowOptions, &out.AllowOptions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustUntrustedCert != nil {
+		in, out := &in.TrustUntrustedCert, &out.TrustUntrustedCert
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientlessApp.
+func (in *ClientlessApp) DeepCopy() *ClientlessApp {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientlessApp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientlessAppObservation) DeepCopyInto(out *ClientlessAppObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientlessAppObservation.
+func (in *ClientlessAppObservation) DeepCopy() *ClientlessAppObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientlessAppObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomApplicationSegmentParameters) DeepCopyInto(out *CustomApplicationSegmentParameters) {
 	*out = *in
@@ -204,9 +262,39 @@ func (in *CustomApplicationSegmentParameters) DeepCopy() *CustomApplicationSegme
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomClientlessAppParameters) DeepCopyInto(out *CustomClientlessAppParameters) {
+	*out = *in
+	if in.CertificateIDRef != nil {
+		in, out := &in.CertificateIDRef, &out.CertificateIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CertificateIDSelector != nil {
+		in, out := &in.CertificateIDSelector, &out.CertificateIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomClientlessAppParameters.
+func (in *CustomClientlessAppParameters) DeepCopy() *CustomClientlessAppParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomClientlessAppParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Observation) DeepCopyInto(out *Observation) {
 	*out = *in
+	if in.ClientlessApps != nil {
+		in, out := &in.ClientlessApps, &out.ClientlessApps
+		*out = make([]ClientlessAppObservation, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A BACertificateParameters defines desired state of a BACertificate
+type BACertificateParameters struct {
+	// Description of the certificate. It cannot be changed, because the ZPA
+	// API cannot update certificates. Recreate the BACertificate to change it.
+	Description string `json:"description,omitempty"`
+
+	// CertificateSecretRef references a Secret of type kubernetes.io/tls.
+	// The certificate in its tls.crt key, which may be followed by its chain,
+	// and the private key in its tls.key key are uploaded to ZPA.
+	// They are uploaded only when the certificate is created, because the ZPA
+	// API cannot update certificates. Recreate the BACertificate to rotate the
+	// certificate.
+	// +kubebuilder:validation:Required
+	CertificateSecretRef xpv1.SecretReference `json:"certificateSecretRef"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A BACertificateSpec defines the desired state of a BACertificate.
+type BACertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BACertificateParameters `json:"forProvider"`
+}
+
+// A BACertificateStatus represents the status of a BACertificate.
+type BACertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a BACertificate.
+type Observation struct {
+	CreationTime        string   `json:"creationTime,omitempty"`
+	ModifiedBy          string   `json:"modifiedBy,omitempty"`
+	ModifiedTime        string   `json:"modifiedTime,omitempty"`
+	ID                  string   `json:"id,omitempty"`
+	CName               string   `json:"cName,omitempty"`
+	IssuedBy            string   `json:"issuedBy,omitempty"`
+	IssuedTo            string   `json:"issuedTo,omitempty"`
+	San                 []string `json:"san,omitempty"`
+	SerialNo            string   `json:"serialNo,omitempty"`
+	Status              string   `json:"status,omitempty"`
+	ValidFromInEpochSec string   `json:"validFromInEpochSec,omitempty"`
+	ValidToInEpochSec   string   `json:"validToInEpochSec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BACertificate is a certificate that browser access applications of an
+// ApplicationSegment are served with. ZPA does not support changing a
+// certificate, so a BACertificate must be recreated to rotate it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CNAME",type="string",JSONPath=".status.atProvider.cName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type BACertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BACertificateSpec   `json:"spec"`
+	Status BACertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BACertificateList contains a list of BACertificate
+type BACertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BACertificate `json:"items"`
+}
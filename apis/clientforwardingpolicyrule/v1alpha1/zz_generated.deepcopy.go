@@ -0,0 +1,161 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	commonv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientForwardingPolicyRule) DeepCopyInto(out *ClientForwardingPolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientForwardingPolicyRule.
+func (in *ClientForwardingPolicyRule) DeepCopy() *ClientForwardingPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientForwardingPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientForwardingPolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientForwardingPolicyRuleList) DeepCopyInto(out *ClientForwardingPolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientForwardingPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientForwardingPolicyRuleList.
+func (in *ClientForwardingPolicyRuleList) DeepCopy() *ClientForwardingPolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientForwardingPolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientForwardingPolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientForwardingPolicyRuleParameters) DeepCopyInto(out *ClientForwardingPolicyRuleParameters) {
+	*out = *in
+	if in.RuleOrder != nil {
+		in, out := &in.RuleOrder, &out.RuleOrder
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]commonv1alpha1.PolicyRuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientForwardingPolicyRuleParameters.
+func (in *ClientForwardingPolicyRuleParameters) DeepCopy() *ClientForwardingPolicyRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientForwardingPolicyRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientForwardingPolicyRuleSpec) DeepCopyInto(out *ClientForwardingPolicyRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientForwardingPolicyRuleSpec.
+func (in *ClientForwardingPolicyRuleSpec) DeepCopy() *ClientForwardingPolicyRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientForwardingPolicyRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientForwardingPolicyRuleStatus) DeepCopyInto(out *ClientForwardingPolicyRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientForwardingPolicyRuleStatus.
+func (in *ClientForwardingPolicyRuleStatus) DeepCopy() *ClientForwardingPolicyRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientForwardingPolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
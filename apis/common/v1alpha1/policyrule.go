@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Object types of the operands of a policy rule condition.
+const (
+	ObjectTypeApp            = "APP"
+	ObjectTypeAppGroup       = "APP_GROUP"
+	ObjectTypeSAML           = "SAML"
+	ObjectTypeSCIM           = "SCIM"
+	ObjectTypeSCIMGroup      = "SCIM_GROUP"
+	ObjectTypePosture        = "POSTURE"
+	ObjectTypeTrustedNetwork = "TRUSTED_NETWORK"
+	ObjectTypeClientType     = "CLIENT_TYPE"
+	ObjectTypeMachineGroup   = "MACHINE_GRP"
+)
+
+// A PolicyRuleCondition of a policy rule matches if its operands match,
+// either all of them or any of them.
+type PolicyRuleCondition struct {
+	// negated
+	// +optional
+	Negated bool `json:"negated,omitempty"`
+
+	// operator
+	// +kubebuilder:validation:Enum=AND;OR
+	// +kubebuilder:validation:Required
+	Operator string `json:"operator"`
+
+	// operands
+	// +kubebuilder:validation:MinItems=1
+	Operands []PolicyRuleOperand `json:"operands"`
+}
+
+// CustomPolicyRuleOperandParameters that are not part of the ZPA API
+type CustomPolicyRuleOperandParameters struct {
+	// ApplicationSegmentRef is a reference to a ApplicationSegment so set
+	// external ID as RHS of an APP operand
+	// +optional
+	ApplicationSegmentRef *xpv1.Reference `json:"applicationSegmentRef,omitempty"`
+
+	// ApplicationSegmentSelector selects a reference to a ApplicationSegment
+	// so set external ID as RHS of an APP operand
+	// +optional
+	ApplicationSegmentSelector *xpv1.Selector `json:"applicationSegmentSelector,omitempty"`
+
+	// SegmentGroupRef is a reference to a SegmentGroup so set external ID as
+	// RHS of an APP_GROUP operand
+	// +optional
+	SegmentGroupRef *xpv1.Reference `json:"segmentGroupRef,omitempty"`
+
+	// SegmentGroupSelector selects a reference to a SegmentGroup so set
+	// external ID as RHS of an APP_GROUP operand
+	// +optional
+	SegmentGroupSelector *xpv1.Selector `json:"segmentGroupSelector,omitempty"`
+
+	// IdpRef is a reference to a Idp so set external ID as IdpID of a SAML
+	// or SCIM operand and as LHS of a SCIM_GROUP operand
+	// +optional
+	IdpRef *xpv1.Reference `json:"idpRef,omitempty"`
+
+	// IdpSelector selects a reference to a Idp so set external ID as IdpID
+	// of a SAML or SCIM operand and as LHS of a SCIM_GROUP operand
+	// +optional
+	IdpSelector *xpv1.Selector `json:"idpSelector,omitempty"`
+
+	// SAMLAttributeRef is a reference to a SAMLAttribute so set external ID
+	// as LHS of a SAML operand
+	// +optional
+	SAMLAttributeRef *xpv1.Reference `json:"samlAttributeRef,omitempty"`
+
+	// SAMLAttributeSelector selects a reference to a SAMLAttribute so set
+	// external ID as LHS of a SAML operand
+	// +optional
+	SAMLAttributeSelector *xpv1.Selector `json:"samlAttributeSelector,omitempty"`
+
+	// SCIMGroupRef is a reference to a SCIMGroup so set external ID as RHS
+	// of a SCIM_GROUP operand
+	// +optional
+	SCIMGroupRef *xpv1.Reference `json:"scimGroupRef,omitempty"`
+
+	// SCIMGroupSelector selects a reference to a SCIMGroup so set external
+	// ID as RHS of a SCIM_GROUP operand
+	// +optional
+	SCIMGroupSelector *xpv1.Selector `json:"scimGroupSelector,omitempty"`
+
+	// PostureProfileRef is a reference to a PostureProfile so set its UDID
+	// as LHS of a POSTURE operand
+	// +optional
+	PostureProfileRef *xpv1.Reference `json:"postureProfileRef,omitempty"`
+
+	// PostureProfileSelector selects a reference to a PostureProfile so set
+	// its UDID as LHS of a POSTURE operand
+	// +optional
+	PostureProfileSelector *xpv1.Selector `json:"postureProfileSelector,omitempty"`
+
+	// TrustedNetworkRef is a reference to a TrustedNetwork so set its
+	// network ID as LHS of a TRUSTED_NETWORK operand
+	// +optional
+	TrustedNetworkRef *xpv1.Reference `json:"trustedNetworkRef,omitempty"`
+
+	// TrustedNetworkSelector selects a reference to a TrustedNetwork so set
+	// its network ID as LHS of a TRUSTED_NETWORK operand
+	// +optional
+	TrustedNetworkSelector *xpv1.Selector `json:"trustedNetworkSelector,omitempty"`
+
+	// MachineGroupRef is a reference to a MachineGroup so set external ID as
+	// RHS of a MACHINE_GRP operand
+	// +optional
+	MachineGroupRef *xpv1.Reference `json:"machineGroupRef,omitempty"`
+
+	// MachineGroupSelector selects a reference to a MachineGroup so set
+	// external ID as RHS of a MACHINE_GRP operand
+	// +optional
+	MachineGroupSelector *xpv1.Selector `json:"machineGroupSelector,omitempty"`
+}
+
+// A PolicyRuleOperand of a policy rule condition. The meaning of LHS and RHS
+// depends on the object type:
+//
+//   - APP: RHS is the ID of an application segment.
+//   - APP_GROUP: RHS is the ID of a segment group.
+//   - SAML: LHS is the ID of a SAML attribute of the identity provider IdpID
+//     and RHS is its value.
+//   - SCIM: LHS is the ID of a SCIM attribute of the identity provider IdpID
+//     and RHS is its value.
+//   - SCIM_GROUP: LHS is the ID of an identity provider and RHS is the ID of
+//     one of its SCIM groups.
+//   - POSTURE: LHS is the UDID of a posture profile and RHS is "true" or
+//     "false".
+//   - TRUSTED_NETWORK: LHS is the network ID of a trusted network and RHS is
+//     "true".
+//   - CLIENT_TYPE: RHS is a client type, e.g. zpn_client_type_zapp.
+//   - MACHINE_GRP: RHS is the ID of a machine group.
+type PolicyRuleOperand struct {
+	CustomPolicyRuleOperandParameters `json:",inline"`
+
+	// object type
+	// +kubebuilder:validation:Enum=APP;APP_GROUP;SAML;SCIM;SCIM_GROUP;POSTURE;TRUSTED_NETWORK;CLIENT_TYPE;MACHINE_GRP
+	// +kubebuilder:validation:Required
+	ObjectType string `json:"objectType"`
+
+	// LHS defaults to "id" for APP, APP_GROUP, CLIENT_TYPE and MACHINE_GRP
+	// operands.
+	// +optional
+	LHS string `json:"lhs,omitempty"`
+
+	// rhs
+	// +optional
+	RHS *string `json:"rhs,omitempty"`
+
+	// idp id
+	// +optional
+	IdpID string `json:"idpID,omitempty"`
+}
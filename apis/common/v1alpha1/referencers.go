@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	applicationSegment "github.com/crossplane-contrib/provider-zpa/apis/applicationsegment/v1alpha1"
+	idp "github.com/crossplane-contrib/provider-zpa/apis/idp/v1alpha1"
+	machineGroup "github.com/crossplane-contrib/provider-zpa/apis/machinegroup/v1alpha1"
+	postureProfile "github.com/crossplane-contrib/provider-zpa/apis/postureprofile/v1alpha1"
+	samlAttribute "github.com/crossplane-contrib/provider-zpa/apis/samlattribute/v1alpha1"
+	scimGroup "github.com/crossplane-contrib/provider-zpa/apis/scimgroup/v1alpha1"
+	segmentGroup "github.com/crossplane-contrib/provider-zpa/apis/segmentgroup/v1alpha1"
+	trustedNetwork "github.com/crossplane-contrib/provider-zpa/apis/trustednetwork/v1alpha1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+)
+
+// ResolvePolicyRuleConditions resolves the references of the operands of the
+// supplied conditions, which are found at the supplied path of a managed
+// resource.
+func ResolvePolicyRuleConditions(ctx context.Context, r *reference.APIResolver, path string, conditions []PolicyRuleCondition) error {
+	for i := range conditions {
+		for j := range conditions[i].Operands {
+			o := &conditions[i].Operands[j]
+			p := fmt.Sprintf("%s[%d].operands[%d]", path, i, j)
+
+			// Resolve rhs of APP operands
+			if o.ObjectType == ObjectTypeApp {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: reference.FromPtrValue(o.RHS),
+					Reference:    o.ApplicationSegmentRef,
+					Selector:     o.ApplicationSegmentSelector,
+					To:           reference.To{Managed: &applicationSegment.ApplicationSegment{}, List: &applicationSegment.ApplicationSegmentList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".rhs")
+				}
+				o.RHS = reference.ToPtrValue(rsp.ResolvedValue)
+				o.ApplicationSegmentRef = rsp.ResolvedReference
+			}
+
+			// Resolve rhs of APP_GROUP operands
+			if o.ObjectType == ObjectTypeAppGroup {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: reference.FromPtrValue(o.RHS),
+					Reference:    o.SegmentGroupRef,
+					Selector:     o.SegmentGroupSelector,
+					To:           reference.To{Managed: &segmentGroup.SegmentGroup{}, List: &segmentGroup.SegmentGroupList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".rhs")
+				}
+				o.RHS = reference.ToPtrValue(rsp.ResolvedValue)
+				o.SegmentGroupRef = rsp.ResolvedReference
+			}
+
+			// Resolve idpID of SAML and SCIM operands
+			if o.ObjectType == ObjectTypeSAML || o.ObjectType == ObjectTypeSCIM {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: o.IdpID,
+					Reference:    o.IdpRef,
+					Selector:     o.IdpSelector,
+					To:           reference.To{Managed: &idp.Idp{}, List: &idp.IdpList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".idpID")
+				}
+				o.IdpID = rsp.ResolvedValue
+				o.IdpRef = rsp.ResolvedReference
+			}
+
+			// Resolve lhs of SAML operands
+			if o.ObjectType == ObjectTypeSAML {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: o.LHS,
+					Reference:    o.SAMLAttributeRef,
+					Selector:     o.SAMLAttributeSelector,
+					To:           reference.To{Managed: &samlAttribute.SAMLAttribute{}, List: &samlAttribute.SAMLAttributeList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".lhs")
+				}
+				o.LHS = rsp.ResolvedValue
+				o.SAMLAttributeRef = rsp.ResolvedReference
+			}
+
+			// Resolve lhs and rhs of SCIM_GROUP operands
+			if o.ObjectType == ObjectTypeSCIMGroup {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: o.LHS,
+					Reference:    o.IdpRef,
+					Selector:     o.IdpSelector,
+					To:           reference.To{Managed: &idp.Idp{}, List: &idp.IdpList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".lhs")
+				}
+				o.LHS = rsp.ResolvedValue
+				o.IdpRef = rsp.ResolvedReference
+
+				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: reference.FromPtrValue(o.RHS),
+					Reference:    o.SCIMGroupRef,
+					Selector:     o.SCIMGroupSelector,
+					To:           reference.To{Managed: &scimGroup.SCIMGroup{}, List: &scimGroup.SCIMGroupList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".rhs")
+				}
+				o.RHS = reference.ToPtrValue(rsp.ResolvedValue)
+				o.SCIMGroupRef = rsp.ResolvedReference
+			}
+
+			// Resolve lhs of POSTURE operands
+			if o.ObjectType == ObjectTypePosture {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: o.LHS,
+					Reference:    o.PostureProfileRef,
+					Selector:     o.PostureProfileSelector,
+					To:           reference.To{Managed: &postureProfile.PostureProfile{}, List: &postureProfile.PostureProfileList{}},
+					Extract:      postureProfile.PostureUdid(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".lhs")
+				}
+				o.LHS = rsp.ResolvedValue
+				o.PostureProfileRef = rsp.ResolvedReference
+			}
+
+			// Resolve lhs of TRUSTED_NETWORK operands
+			if o.ObjectType == ObjectTypeTrustedNetwork {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: o.LHS,
+					Reference:    o.TrustedNetworkRef,
+					Selector:     o.TrustedNetworkSelector,
+					To:           reference.To{Managed: &trustedNetwork.TrustedNetwork{}, List: &trustedNetwork.TrustedNetworkList{}},
+					Extract:      trustedNetwork.NetworkID(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".lhs")
+				}
+				o.LHS = rsp.ResolvedValue
+				o.TrustedNetworkRef = rsp.ResolvedReference
+			}
+
+			// Resolve rhs of MACHINE_GRP operands
+			if o.ObjectType == ObjectTypeMachineGroup {
+				rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: reference.FromPtrValue(o.RHS),
+					Reference:    o.MachineGroupRef,
+					Selector:     o.MachineGroupSelector,
+					To:           reference.To{Managed: &machineGroup.MachineGroup{}, List: &machineGroup.MachineGroupList{}},
+					Extract:      reference.ExternalName(),
+				})
+				if err != nil {
+					return errors.Wrap(err, p+".rhs")
+				}
+				o.RHS = reference.ToPtrValue(rsp.ResolvedValue)
+				o.MachineGroupRef = rsp.ResolvedReference
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyRuleOperandParameters) DeepCopyInto(out *CustomPolicyRuleOperandParameters) {
+	*out = *in
+	if in.ApplicationSegmentRef != nil {
+		in, out := &in.ApplicationSegmentRef, &out.ApplicationSegmentRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ApplicationSegmentSelector != nil {
+		in, out := &in.ApplicationSegmentSelector, &out.ApplicationSegmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SegmentGroupRef != nil {
+		in, out := &in.SegmentGroupRef, &out.SegmentGroupRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SegmentGroupSelector != nil {
+		in, out := &in.SegmentGroupSelector, &out.SegmentGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdpRef != nil {
+		in, out := &in.IdpRef, &out.IdpRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IdpSelector != nil {
+		in, out := &in.IdpSelector, &out.IdpSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAMLAttributeRef != nil {
+		in, out := &in.SAMLAttributeRef, &out.SAMLAttributeRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SAMLAttributeSelector != nil {
+		in, out := &in.SAMLAttributeSelector, &out.SAMLAttributeSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SCIMGroupRef != nil {
+		in, out := &in.SCIMGroupRef, &out.SCIMGroupRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SCIMGroupSelector != nil {
+		in, out := &in.SCIMGroupSelector, &out.SCIMGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostureProfileRef != nil {
+		in, out := &in.PostureProfileRef, &out.PostureProfileRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PostureProfileSelector != nil {
+		in, out := &in.PostureProfileSelector, &out.PostureProfileSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedNetworkRef != nil {
+		in, out := &in.TrustedNetworkRef, &out.TrustedNetworkRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TrustedNetworkSelector != nil {
+		in, out := &in.TrustedNetworkSelector, &out.TrustedNetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineGroupRef != nil {
+		in, out := &in.MachineGroupRef, &out.MachineGroupRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.MachineGroupSelector != nil {
+		in, out := &in.MachineGroupSelector, &out.MachineGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyRuleOperandParameters.
+func (in *CustomPolicyRuleOperandParameters) DeepCopy() *CustomPolicyRuleOperandParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyRuleOperandParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRuleCondition) DeepCopyInto(out *PolicyRuleCondition) {
+	*out = *in
+	if in.Operands != nil {
+		in, out := &in.Operands, &out.Operands
+		*out = make([]PolicyRuleOperand, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRuleCondition.
+func (in *PolicyRuleCondition) DeepCopy() *PolicyRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRuleOperand) DeepCopyInto(out *PolicyRuleOperand) {
+	*out = *in
+	in.CustomPolicyRuleOperandParameters.DeepCopyInto(&out.CustomPolicyRuleOperandParameters)
+	if in.RHS != nil {
+		in, out := &in.RHS, &out.RHS
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRuleOperand.
+func (in *PolicyRuleOperand) DeepCopy() *PolicyRuleOperand {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRuleOperand)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// An EnrollmentCertParameters defines the enrollment certificate that an
+// EnrollmentCert looks up.
+type EnrollmentCertParameters struct {
+	// Name of the enrollment certificate in ZPA, e.g. Root, Client,
+	// Connector or Service Edge.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// An EnrollmentCertSpec defines the desired state of an EnrollmentCert.
+type EnrollmentCertSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnrollmentCertParameters `json:"forProvider"`
+}
+
+// An EnrollmentCertStatus represents the status of an EnrollmentCert.
+type EnrollmentCertStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of an EnrollmentCert.
+type Observation struct {
+	CreationTime        string `json:"creationTime,omitempty"`
+	ModifiedBy          string `json:"modifiedBy,omitempty"`
+	ModifiedTime        string `json:"modifiedTime,omitempty"`
+	ID                  string `json:"id,omitempty"`
+	Description         string `json:"description,omitempty"`
+	ClientCertType      string `json:"clientCertType,omitempty"`
+	IssuedBy            string `json:"issuedBy,omitempty"`
+	IssuedTo            string `json:"issuedTo,omitempty"`
+	ParentCertID        string `json:"parentCertID,omitempty"`
+	ParentCertName      string `json:"parentCertName,omitempty"`
+	SerialNo            string `json:"serialNo,omitempty"`
+	ValidFromInEpochSec string `json:"validFromInEpochSec,omitempty"`
+	ValidToInEpochSec   string `json:"validToInEpochSec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EnrollmentCert looks up an enrollment certificate of ZPA by name.
+// Enrollment certificates are generated by the tenant, so an EnrollmentCert
+// never creates, updates or deletes one. Its external name is set to the ID of
+// the enrollment certificate, so that provisioning keys can reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ISSUED-TO",type="string",JSONPath=".status.atProvider.issuedTo"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type EnrollmentCert struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnrollmentCertSpec   `json:"spec"`
+	Status EnrollmentCertStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnrollmentCertList contains a list of EnrollmentCert
+type EnrollmentCertList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnrollmentCert `json:"items"`
+}
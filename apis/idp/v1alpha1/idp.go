@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A IdpParameters defines the identity provider that a Idp looks up.
+type IdpParameters struct {
+	// Name of the identity provider in ZPA.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A IdpSpec defines the desired state of a Idp.
+type IdpSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdpParameters `json:"forProvider"`
+}
+
+// A IdpStatus represents the status of a Idp.
+type IdpStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a Idp.
+type Observation struct {
+	CreationTime string   `json:"creationTime,omitempty"`
+	ModifiedBy   string   `json:"modifiedBy,omitempty"`
+	ModifiedTime string   `json:"modifiedTime,omitempty"`
+	ID           string   `json:"id,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Enabled      bool     `json:"enabled,omitempty"`
+	ScimEnabled  bool     `json:"scimEnabled,omitempty"`
+	SsoType      []string `json:"ssoType,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Idp looks up an identity provider of ZPA by name. Identity providers are
+// configured in the ZPA admin portal, so a Idp never creates, updates or
+// deletes one. Its external name is set to the ID of the identity provider,
+// so that other resources can reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type Idp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdpSpec   `json:"spec"`
+	Status IdpStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdpList contains a list of Idp
+type IdpList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Idp `json:"items"`
+}
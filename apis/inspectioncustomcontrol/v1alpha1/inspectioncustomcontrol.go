@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A InspectionCustomControlParameters defines desired state of a InspectionCustomControl
+type InspectionCustomControlParameters struct {
+	// description
+	Description string `json:"description,omitempty"`
+
+	// Type of the HTTP messages the control inspects.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=REQUEST;RESPONSE
+	Type string `json:"type"`
+
+	// Action taken if the control matches.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=PASS;BLOCK;REDIRECT
+	Action string `json:"action"`
+
+	// ActionValue is the URL that is redirected to if the action is
+	// REDIRECT.
+	ActionValue string `json:"actionValue,omitempty"`
+
+	// severity
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=CRITICAL;ERROR;WARNING;INFO
+	Severity string `json:"severity"`
+
+	// paranoia level
+	// +kubebuilder:validation:Pattern=`^[1-4]$`
+	ParanoiaLevel string `json:"paranoiaLevel,omitempty"`
+
+	// Rules of the control. The control matches if any of its rules
+	// matches.
+	// +kubebuilder:validation:MinItems=1
+	Rules []Rule `json:"rules"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A Rule of an InspectionCustomControl. It matches if all of its conditions
+// match the part of an HTTP message of its type.
+type Rule struct {
+	// Type is the part of an HTTP message the rule inspects.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=REQUEST_HEADERS;REQUEST_URI;QUERY_STRING;REQUEST_COOKIES;REQUEST_METHOD;REQUEST_BODY;RESPONSE_HEADERS;RESPONSE_BODY
+	Type string `json:"type"`
+
+	// Names of the headers or cookies the rule inspects, for the types
+	// REQUEST_HEADERS, REQUEST_COOKIES and RESPONSE_HEADERS.
+	Names []string `json:"names,omitempty"`
+
+	// conditions
+	// +kubebuilder:validation:MinItems=1
+	Conditions []RuleCondition `json:"conditions"`
+}
+
+// A RuleCondition of a Rule.
+type RuleCondition struct {
+	// LHS is whether the value or the size of the inspected part is
+	// compared.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=VALUE;SIZE
+	LHS string `json:"lhs"`
+
+	// OP is the operator the inspected part is compared with.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=RX;CONTAINS;STARTS_WITH;ENDS_WITH;EQ;LE;GE
+	OP string `json:"op"`
+
+	// RHS is the value the inspected part is compared to.
+	// +kubebuilder:validation:Required
+	RHS string `json:"rhs"`
+}
+
+// A InspectionCustomControlSpec defines the desired state of a InspectionCustomControl.
+type InspectionCustomControlSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InspectionCustomControlParameters `json:"forProvider"`
+}
+
+// A InspectionCustomControlStatus represents the status of a InspectionCustomControl.
+type InspectionCustomControlStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a InspectionCustomControl.
+type Observation struct {
+	CreationTime  string `json:"creationTime,omitempty"`
+	ModifiedBy    string `json:"modifiedBy,omitempty"`
+	ModifiedTime  string `json:"modifiedTime,omitempty"`
+	ID            string `json:"id,omitempty"`
+	ControlNumber string `json:"controlNumber,omitempty"`
+
+	// InspectionProfiles that the control is a control of, by name.
+	InspectionProfiles []string `json:"inspectionProfiles,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InspectionCustomControl is a control of AppProtection that inspects HTTP
+// messages using custom rules. InspectionProfiles reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="SEVERITY",type="string",JSONPath=".spec.forProvider.severity",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type InspectionCustomControl struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InspectionCustomControlSpec   `json:"spec"`
+	Status InspectionCustomControlStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InspectionCustomControlList contains a list of InspectionCustomControl
+type InspectionCustomControlList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InspectionCustomControl `json:"items"`
+}
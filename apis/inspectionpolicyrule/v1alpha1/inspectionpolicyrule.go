@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+// CustomInspectionPolicyRuleParameters that are not part of the ZPA API
+type CustomInspectionPolicyRuleParameters struct {
+	// ZpnInspectionProfileIDRef is a reference to an InspectionProfile so set external ID
+	// +optional
+	ZpnInspectionProfileIDRef *xpv1.Reference `json:"zpnInspectionProfileIDRef,omitempty"`
+
+	// ZpnInspectionProfileIDSelector selects a reference to an InspectionProfile so set external ID
+	// +optional
+	ZpnInspectionProfileIDSelector *xpv1.Selector `json:"zpnInspectionProfileIDSelector,omitempty"`
+}
+
+// A InspectionPolicyRuleParameters defines desired state of a InspectionPolicyRule
+type InspectionPolicyRuleParameters struct {
+	CustomInspectionPolicyRuleParameters `json:",inline"`
+
+	// Action INSPECT inspects matching traffic with the inspection profile
+	// and BYPASS_INSPECT exempts it from inspection.
+	// +kubebuilder:validation:Enum=INSPECT;BYPASS_INSPECT
+	// +kubebuilder:validation:Required
+	Action string `json:"action"`
+
+	// ZpnInspectionProfileID is the ID of the InspectionProfile that
+	// matching traffic is inspected with. It is required if the action is
+	// INSPECT.
+	ZpnInspectionProfileID *string `json:"zpnInspectionProfileID,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// operator
+	// +kubebuilder:validation:Enum=AND;OR
+	Operator string `json:"operator,omitempty"`
+
+	// RuleOrder is the position of the rule in the inspection policy, starting
+	// at 1. Rules are evaluated in order.
+	// +kubebuilder:validation:Minimum=1
+	RuleOrder *int32 `json:"ruleOrder,omitempty"`
+
+	// conditions
+	Conditions []common.PolicyRuleCondition `json:"conditions,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A InspectionPolicyRuleSpec defines the desired state of a InspectionPolicyRule.
+type InspectionPolicyRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InspectionPolicyRuleParameters `json:"forProvider"`
+}
+
+// A InspectionPolicyRuleStatus represents the status of a InspectionPolicyRule.
+type InspectionPolicyRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a InspectionPolicyRule.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	PolicySetID  string `json:"policySetID,omitempty"`
+	Priority     int32  `json:"priority,omitempty"`
+	RuleOrder    int32  `json:"ruleOrder,omitempty"`
+
+	// ZpnInspectionProfileName is the name of the inspection profile of the
+	// rule.
+	ZpnInspectionProfileName string `json:"zpnInspectionProfileName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InspectionPolicyRule is the schema for ZPA InspectionPolicyRules API. Its
+// rule is added to the inspection policy set of the customer.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="PROFILE",type="string",JSONPath=".status.atProvider.zpnInspectionProfileName"
+// +kubebuilder:printcolumn:name="ORDER",type="integer",JSONPath=".spec.forProvider.ruleOrder",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type InspectionPolicyRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InspectionPolicyRuleSpec   `json:"spec"`
+	Status InspectionPolicyRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InspectionPolicyRuleList contains a list of InspectionPolicyRule
+type InspectionPolicyRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InspectionPolicyRule `json:"items"`
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	inspectionProfile "github.com/crossplane-contrib/provider-zpa/apis/inspectionprofile/v1alpha1"
+)
+
+// ResolveReferences of this InspectionPolicyRule
+func (mg *InspectionPolicyRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.zpnInspectionProfileID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ZpnInspectionProfileID),
+		Reference:    mg.Spec.ForProvider.ZpnInspectionProfileIDRef,
+		Selector:     mg.Spec.ForProvider.ZpnInspectionProfileIDSelector,
+		To:           reference.To{Managed: &inspectionProfile.InspectionProfile{}, List: &inspectionProfile.InspectionProfileList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zpnInspectionProfileID")
+	}
+	mg.Spec.ForProvider.ZpnInspectionProfileID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ZpnInspectionProfileIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.conditions
+	return common.ResolvePolicyRuleConditions(ctx, r, "spec.forProvider.conditions", mg.Spec.ForProvider.Conditions)
+}
@@ -0,0 +1,193 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	commonv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomInspectionPolicyRuleParameters) DeepCopyInto(out *CustomInspectionPolicyRuleParameters) {
+	*out = *in
+	if in.ZpnInspectionProfileIDRef != nil {
+		in, out := &in.ZpnInspectionProfileIDRef, &out.ZpnInspectionProfileIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ZpnInspectionProfileIDSelector != nil {
+		in, out := &in.ZpnInspectionProfileIDSelector, &out.ZpnInspectionProfileIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInspectionPolicyRuleParameters.
+func (in *CustomInspectionPolicyRuleParameters) DeepCopy() *CustomInspectionPolicyRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomInspectionPolicyRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionPolicyRule) DeepCopyInto(out *InspectionPolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionPolicyRule.
+func (in *InspectionPolicyRule) DeepCopy() *InspectionPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InspectionPolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionPolicyRuleList) DeepCopyInto(out *InspectionPolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InspectionPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionPolicyRuleList.
+func (in *InspectionPolicyRuleList) DeepCopy() *InspectionPolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionPolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InspectionPolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionPolicyRuleParameters) DeepCopyInto(out *InspectionPolicyRuleParameters) {
+	*out = *in
+	in.CustomInspectionPolicyRuleParameters.DeepCopyInto(&out.CustomInspectionPolicyRuleParameters)
+	if in.ZpnInspectionProfileID != nil {
+		in, out := &in.ZpnInspectionProfileID, &out.ZpnInspectionProfileID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RuleOrder != nil {
+		in, out := &in.RuleOrder, &out.RuleOrder
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]commonv1alpha1.PolicyRuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionPolicyRuleParameters.
+func (in *InspectionPolicyRuleParameters) DeepCopy() *InspectionPolicyRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionPolicyRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionPolicyRuleSpec) DeepCopyInto(out *InspectionPolicyRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionPolicyRuleSpec.
+func (in *InspectionPolicyRuleSpec) DeepCopy() *InspectionPolicyRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionPolicyRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionPolicyRuleStatus) DeepCopyInto(out *InspectionPolicyRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionPolicyRuleStatus.
+func (in *InspectionPolicyRuleStatus) DeepCopy() *InspectionPolicyRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionPolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
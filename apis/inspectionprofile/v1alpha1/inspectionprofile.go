@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A InspectionProfileParameters defines desired state of a InspectionProfile
+type InspectionProfileParameters struct {
+	// description
+	Description string `json:"description,omitempty"`
+
+	// ParanoiaLevel of the predefined controls, from 1, the least, to 4, the
+	// most likely to block legitimate traffic.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[1-4]$`
+	ParanoiaLevel string `json:"paranoiaLevel"`
+
+	// PredefinedControlsVersion is the version of the set of predefined
+	// controls, e.g. OWASP_CRS/3.3.0.
+	PredefinedControlsVersion string `json:"predefinedControlsVersion,omitempty"`
+
+	// PredefinedControls of the set and the actions they take.
+	// +optional
+	PredefinedControls []PredefinedControl `json:"predefinedControls,omitempty"`
+
+	// CustomControls and the actions they take.
+	// +optional
+	CustomControls []CustomControl `json:"customControls,omitempty"`
+
+	// GlobalControlActions override the actions of all controls of a type,
+	// e.g. PREDEFINED:PASS, CUSTOM:NONE or OVERRIDE_ACTION:COMMON.
+	// +optional
+	GlobalControlActions []string `json:"globalControlActions,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A PredefinedControl of an InspectionProfile.
+type PredefinedControl struct {
+	// ID of the predefined control in ZPA.
+	// +kubebuilder:validation:Required
+	ID string `json:"id"`
+
+	// Action taken if the control matches. It defaults to the action of the
+	// predefined control.
+	// +kubebuilder:validation:Enum=PASS;BLOCK;REDIRECT
+	Action string `json:"action,omitempty"`
+
+	// ActionValue is the URL that is redirected to if the action is
+	// REDIRECT.
+	ActionValue string `json:"actionValue,omitempty"`
+}
+
+// CustomCustomControlParameters that are not part of the ZPA API
+type CustomCustomControlParameters struct {
+	// IDRef is a reference to an InspectionCustomControl so set external ID
+	// +optional
+	IDRef *xpv1.Reference `json:"idRef,omitempty"`
+
+	// IDSelector selects a reference to an InspectionCustomControl so set external ID
+	// +optional
+	IDSelector *xpv1.Selector `json:"idSelector,omitempty"`
+}
+
+// A CustomControl of an InspectionProfile.
+type CustomControl struct {
+	CustomCustomControlParameters `json:",inline"`
+
+	// ID of an InspectionCustomControl
+	ID *string `json:"id,omitempty"`
+
+	// Action taken if the control matches. It defaults to the action of the
+	// InspectionCustomControl.
+	// +kubebuilder:validation:Enum=PASS;BLOCK;REDIRECT
+	Action string `json:"action,omitempty"`
+
+	// ActionValue is the URL that is redirected to if the action is
+	// REDIRECT.
+	ActionValue string `json:"actionValue,omitempty"`
+}
+
+// A InspectionProfileSpec defines the desired state of a InspectionProfile.
+type InspectionProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InspectionProfileParameters `json:"forProvider"`
+}
+
+// A InspectionProfileStatus represents the status of a InspectionProfile.
+type InspectionProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a InspectionProfile.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+
+	// ControlsInfo counts the controls of the profile by control type.
+	ControlsInfo []ControlInfo `json:"controlsInfo,omitempty"`
+}
+
+// ControlInfo counts the controls of an InspectionProfile of a control type.
+type ControlInfo struct {
+	ControlType string `json:"controlType,omitempty"`
+	Count       string `json:"count,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InspectionProfile is a set of AppProtection controls that inspection
+// policy rules apply to application segments.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PARANOIA",type="string",JSONPath=".spec.forProvider.paranoiaLevel"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type InspectionProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InspectionProfileSpec   `json:"spec"`
+	Status InspectionProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InspectionProfileList contains a list of InspectionProfile
+type InspectionProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InspectionProfile `json:"items"`
+}
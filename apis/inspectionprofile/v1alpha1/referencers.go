@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	inspectionCustomControl "github.com/crossplane-contrib/provider-zpa/apis/inspectioncustomcontrol/v1alpha1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this InspectionProfile
+func (mg *InspectionProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.customControls[*].id
+	for i := range mg.Spec.ForProvider.CustomControls {
+		cc := &mg.Spec.ForProvider.CustomControls[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(cc.ID),
+			Reference:    cc.IDRef,
+			Selector:     cc.IDSelector,
+			To:           reference.To{Managed: &inspectionCustomControl.InspectionCustomControl{}, List: &inspectionCustomControl.InspectionCustomControlList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.customControls[%d].id", i))
+		}
+		cc.ID = reference.ToPtrValue(rsp.ResolvedValue)
+		cc.IDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
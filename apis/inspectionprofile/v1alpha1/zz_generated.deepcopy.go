@@ -0,0 +1,247 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlInfo) DeepCopyInto(out *ControlInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlInfo.
+func (in *ControlInfo) DeepCopy() *ControlInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomControl) DeepCopyInto(out *CustomControl) {
+	*out = *in
+	in.CustomCustomControlParameters.DeepCopyInto(&out.CustomCustomControlParameters)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomControl.
+func (in *CustomControl) DeepCopy() *CustomControl {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomCustomControlParameters) DeepCopyInto(out *CustomCustomControlParameters) {
+	*out = *in
+	if in.IDRef != nil {
+		in, out := &in.IDRef, &out.IDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IDSelector != nil {
+		in, out := &in.IDSelector, &out.IDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCustomControlParameters.
+func (in *CustomCustomControlParameters) DeepCopy() *CustomCustomControlParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomCustomControlParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionProfile) DeepCopyInto(out *InspectionProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionProfile.
+func (in *InspectionProfile) DeepCopy() *InspectionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InspectionProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionProfileList) DeepCopyInto(out *InspectionProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InspectionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionProfileList.
+func (in *InspectionProfileList) DeepCopy() *InspectionProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InspectionProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionProfileParameters) DeepCopyInto(out *InspectionProfileParameters) {
+	*out = *in
+	if in.PredefinedControls != nil {
+		in, out := &in.PredefinedControls, &out.PredefinedControls
+		*out = make([]PredefinedControl, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomControls != nil {
+		in, out := &in.CustomControls, &out.CustomControls
+		*out = make([]CustomControl, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GlobalControlActions != nil {
+		in, out := &in.GlobalControlActions, &out.GlobalControlActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionProfileParameters.
+func (in *InspectionProfileParameters) DeepCopy() *InspectionProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionProfileSpec) DeepCopyInto(out *InspectionProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionProfileSpec.
+func (in *InspectionProfileSpec) DeepCopy() *InspectionProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InspectionProfileStatus) DeepCopyInto(out *InspectionProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InspectionProfileStatus.
+func (in *InspectionProfileStatus) DeepCopy() *InspectionProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InspectionProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+	if in.ControlsInfo != nil {
+		in, out := &in.ControlsInfo, &out.ControlsInfo
+		*out = make([]ControlInfo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredefinedControl) DeepCopyInto(out *PredefinedControl) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredefinedControl.
+func (in *PredefinedControl) DeepCopy() *PredefinedControl {
+	if in == nil {
+		return nil
+	}
+	out := new(PredefinedControl)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+// A IsolationPolicyRuleParameters defines desired state of a IsolationPolicyRule
+type IsolationPolicyRuleParameters struct {
+	// Action ISOLATE opens matching applications in an isolated browser and
+	// BYPASS_ISOLATE opens them directly.
+	// +kubebuilder:validation:Enum=ISOLATE;BYPASS_ISOLATE
+	// +kubebuilder:validation:Required
+	Action string `json:"action"`
+
+	// ZpnIsolationProfileName is the name of the Cloud Browser Isolation
+	// profile that matching applications are isolated with. It is required
+	// if the action is ISOLATE.
+	ZpnIsolationProfileName string `json:"zpnIsolationProfileName,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// operator
+	// +kubebuilder:validation:Enum=AND;OR
+	Operator string `json:"operator,omitempty"`
+
+	// RuleOrder is the position of the rule in the isolation policy, starting
+	// at 1. Rules are evaluated in order.
+	// +kubebuilder:validation:Minimum=1
+	RuleOrder *int32 `json:"ruleOrder,omitempty"`
+
+	// conditions
+	Conditions []common.PolicyRuleCondition `json:"conditions,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A IsolationPolicyRuleSpec defines the desired state of a IsolationPolicyRule.
+type IsolationPolicyRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IsolationPolicyRuleParameters `json:"forProvider"`
+}
+
+// A IsolationPolicyRuleStatus represents the status of a IsolationPolicyRule.
+type IsolationPolicyRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a IsolationPolicyRule.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	PolicySetID  string `json:"policySetID,omitempty"`
+	Priority     int32  `json:"priority,omitempty"`
+	RuleOrder    int32  `json:"ruleOrder,omitempty"`
+
+	// ZpnCbiProfileID is the ID of the isolation profile of the rule.
+	ZpnCbiProfileID string `json:"zpnCbiProfileID,omitempty"`
+
+	// ZpnIsolationProfileName is the name of the isolation profile that was
+	// last looked up.
+	ZpnIsolationProfileName string `json:"zpnIsolationProfileName,omitempty"`
+
+	// ZpnIsolationProfileID is the ID that ZpnIsolationProfileName was looked
+	// up as. The isolation profile of the rule is compared with it.
+	ZpnIsolationProfileID string `json:"zpnIsolationProfileID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A IsolationPolicyRule is the schema for ZPA IsolationPolicyRules API. Its
+// rule is added to the isolation policy set of the customer.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="PROFILE",type="string",JSONPath=".spec.forProvider.zpnIsolationProfileName"
+// +kubebuilder:printcolumn:name="ORDER",type="integer",JSONPath=".spec.forProvider.ruleOrder",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type IsolationPolicyRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IsolationPolicyRuleSpec   `json:"spec"`
+	Status IsolationPolicyRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IsolationPolicyRuleList contains a list of IsolationPolicyRule
+type IsolationPolicyRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IsolationPolicyRule `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+// CustomLSSConfigParameters that are not part of the ZPA API
+type CustomLSSConfigParameters struct {
+	// AppConnectorGroupsRefs is a reference to a AppConnectorGroups so set external ID
+	// +optional
+	AppConnectorGroupsRefs []xpv1.Reference `json:"appConnectorGroupsRefs,omitempty"`
+
+	// AppConnectorGroupsSelector selects a reference to a AppConnectorGroups so set external ID
+	// +optional
+	AppConnectorGroupsSelector *xpv1.Selector `json:"appConnectorGroupsSelector,omitempty"`
+}
+
+// A LSSConfigParameters defines desired state of a LSSConfig
+type LSSConfigParameters struct {
+	CustomLSSConfigParameters `json:",inline"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// SourceLogType is the type of the logs that are streamed, e.g.
+	// zpn_trans_log for user activity and zpn_ast_auth_log for app connector
+	// status.
+	// +kubebuilder:validation:Enum=zpn_trans_log;zpn_auth_log;zpn_ast_auth_log;zpn_http_trans_log;zpn_audit_log;zpn_sys_auth_log;zpn_ast_comprehensive_stats;zpn_waf_http_exchanges_log
+	// +kubebuilder:validation:Required
+	SourceLogType string `json:"sourceLogType"`
+
+	// LSSHost is the host name or IP address of the log receiver.
+	// +kubebuilder:validation:Required
+	LSSHost string `json:"lssHost"`
+
+	// LSSPort is the TCP port of the log receiver.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	LSSPort string `json:"lssPort"`
+
+	// Format of the log records, usually one of the templates ZPA provides
+	// for the source log type.
+	// +kubebuilder:validation:Required
+	Format string `json:"format"`
+
+	// Filter restricts the logs of the source log type that are streamed,
+	// e.g. to the session statuses ZPN_STATUS_AUTH_FAILED or
+	// BRK_MT_SETUP_FAIL_SAML_EXPIRED.
+	// +optional
+	Filter []string `json:"filter,omitempty"`
+
+	// UseTLS encrypts the traffic to the log receiver.
+	UseTLS *bool `json:"useTLS,omitempty"`
+
+	// AppConnectorGroups are the IDs of the app connector groups that stream
+	// the logs to the log receiver.
+	// +optional
+	AppConnectorGroups []string `json:"appConnectorGroups,omitempty"`
+
+	// Conditions of the policy rule that selects the logs that are
+	// streamed, e.g. by application segment or client type.
+	// +optional
+	Conditions []common.PolicyRuleCondition `json:"conditions,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A LSSConfigSpec defines the desired state of a LSSConfig.
+type LSSConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LSSConfigParameters `json:"forProvider"`
+}
+
+// A LSSConfigStatus represents the status of a LSSConfig.
+type LSSConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a LSSConfig.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+
+	// PolicyRuleID is the ID of the policy rule of the LSS configuration.
+	PolicyRuleID string `json:"policyRuleID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LSSConfig streams logs of a source log type from app connectors to a log
+// receiver, e.g. a SIEM, through the Log Streaming Service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOG-TYPE",type="string",JSONPath=".spec.forProvider.sourceLogType"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".spec.forProvider.lssHost"
+// +kubebuilder:printcolumn:name="PORT",type="string",JSONPath=".spec.forProvider.lssPort"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type LSSConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LSSConfigSpec   `json:"spec"`
+	Status LSSConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LSSConfigList contains a list of LSSConfig
+type LSSConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LSSConfig `json:"items"`
+}
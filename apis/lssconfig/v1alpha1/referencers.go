@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appConnectorGroup "github.com/crossplane-contrib/provider-zpa/apis/appconnectorgroup/v1alpha1"
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+// ResolveReferences of this LSSConfig
+func (mg *LSSConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.appConnectorGroups
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.AppConnectorGroups,
+		References:    mg.Spec.ForProvider.AppConnectorGroupsRefs,
+		Selector:      mg.Spec.ForProvider.AppConnectorGroupsSelector,
+		To:            reference.To{Managed: &appConnectorGroup.AppConnectorGroup{}, List: &appConnectorGroup.AppConnectorGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.appConnectorGroups")
+	}
+	mg.Spec.ForProvider.AppConnectorGroups = mrsp.ResolvedValues
+	mg.Spec.ForProvider.AppConnectorGroupsRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.conditions
+	return common.ResolvePolicyRuleConditions(ctx, r, "spec.forProvider.conditions", mg.Spec.ForProvider.Conditions)
+}
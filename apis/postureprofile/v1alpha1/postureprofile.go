@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A PostureProfileParameters defines the posture profile that a PostureProfile looks
+// up.
+type PostureProfileParameters struct {
+	// Name of the posture profile in ZPA.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A PostureProfileSpec defines the desired state of a PostureProfile.
+type PostureProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PostureProfileParameters `json:"forProvider"`
+}
+
+// A PostureProfileStatus represents the status of a PostureProfile.
+type PostureProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a PostureProfile.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	PostureUdid  string `json:"postureUdid,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+	ZscalerCloud string `json:"zscalerCloud,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PostureProfile looks up a posture profile of ZPA by name. Posture
+// profiles are configured in the ZPA admin portal, so a PostureProfile never
+// creates, updates or deletes one. Its external name is set to the ID of the
+// posture profile and its UDID is observed, so that policy rules can
+// reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="UDID",type="string",JSONPath=".status.atProvider.postureUdid"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type PostureProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostureProfileSpec   `json:"spec"`
+	Status PostureProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostureProfileList contains a list of PostureProfile
+type PostureProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostureProfile `json:"items"`
+}
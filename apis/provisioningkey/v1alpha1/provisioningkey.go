@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConnectionSecretProvisioningKeyKey is the key of the provisioning key in
+// the connection secret of a ProvisioningKey.
+const ConnectionSecretProvisioningKeyKey = "provisioningKey"
+
+// CustomProvisioningKeyParameters that are not part of the ZPA API
+type CustomProvisioningKeyParameters struct {
+	// AppConnectorGroupRef is a reference to a AppConnectorGroup so set ZComponentID
+	// +optional
+	AppConnectorGroupRef *xpv1.Reference `json:"appConnectorGroupRef,omitempty"`
+
+	// AppConnectorGroupSelector selects a reference to a AppConnectorGroup so set ZComponentID
+	// +optional
+	AppConnectorGroupSelector *xpv1.Selector `json:"appConnectorGroupSelector,omitempty"`
+
+	// ServiceEdgeGroupRef is a reference to a ServiceEdgeGroup so set
+	// ZComponentID of a SERVICE_EDGE_GRP key
+	// +optional
+	ServiceEdgeGroupRef *xpv1.Reference `json:"serviceEdgeGroupRef,omitempty"`
+
+	// ServiceEdgeGroupSelector selects a reference to a ServiceEdgeGroup so
+	// set ZComponentID of a SERVICE_EDGE_GRP key
+	// +optional
+	ServiceEdgeGroupSelector *xpv1.Selector `json:"serviceEdgeGroupSelector,omitempty"`
+
+	// EnrollmentCertRef is a reference to an EnrollmentCert so set
+	// EnrollmentCertID
+	// +optional
+	EnrollmentCertRef *xpv1.Reference `json:"enrollmentCertRef,omitempty"`
+
+	// EnrollmentCertSelector selects a reference to an EnrollmentCert so set
+	// EnrollmentCertID
+	// +optional
+	EnrollmentCertSelector *xpv1.Selector `json:"enrollmentCertSelector,omitempty"`
+}
+
+// A ProvisioningKeyParameters defines desired state of a ProvisioningKey
+type ProvisioningKeyParameters struct {
+	CustomProvisioningKeyParameters `json:",inline"`
+
+	// association type, i.e. whether the key enrolls App Connectors or
+	// Service Edges. It cannot be changed once the key is created.
+	// +kubebuilder:validation:Enum=CONNECTOR_GRP;SERVICE_EDGE_GRP
+	// +kubebuilder:validation:Required
+	AssociationType string `json:"associationType"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// max usage, i.e. how many App Connectors or Service Edges can enroll
+	// using the key
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +kubebuilder:validation:Required
+	MaxUsage string `json:"maxUsage"`
+
+	// enrollment cert id
+	// +optional
+	EnrollmentCertID string `json:"enrollmentCertID,omitempty"`
+
+	// zcomponent id, i.e. the ID of the App Connector Group or Service Edge
+	// Group the key enrolls into
+	ZComponentID *string `json:"zComponentID,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A ProvisioningKeySpec defines the desired state of a ProvisioningKey.
+type ProvisioningKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProvisioningKeyParameters `json:"forProvider"`
+}
+
+// A ProvisioningKeyStatus represents the status of a ProvisioningKey.
+type ProvisioningKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a ProvisioningKey.
+type Observation struct {
+	CreationTime         string `json:"creationTime,omitempty"`
+	ModifiedBy           string `json:"modifiedBy,omitempty"`
+	ModifiedTime         string `json:"modifiedTime,omitempty"`
+	ID                   string `json:"id,omitempty"`
+	UsageCount           string `json:"usageCount,omitempty"`
+	ExpirationInEpochSec string `json:"expirationInEpochSec,omitempty"`
+	EnrollmentCertName   string `json:"enrollmentCertName,omitempty"`
+	ZComponentName       string `json:"zComponentName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProvisioningKey is the schema for ZPA ProvisioningKeys API. The key is
+// written to the connection secret of the ProvisioningKey.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="USAGE",type="string",JSONPath=".status.atProvider.usageCount"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type ProvisioningKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProvisioningKeySpec   `json:"spec"`
+	Status ProvisioningKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProvisioningKeyList contains a list of ProvisioningKey
+type ProvisioningKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProvisioningKey `json:"items"`
+}
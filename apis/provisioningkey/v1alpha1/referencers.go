@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	appConnectorGroup "github.com/crossplane-contrib/provider-zpa/apis/appconnectorgroup/v1alpha1"
+	enrollmentCert "github.com/crossplane-contrib/provider-zpa/apis/enrollmentcert/v1alpha1"
+	serviceEdgeGroup "github.com/crossplane-contrib/provider-zpa/apis/serviceedgegroup/v1alpha1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const associationTypeServiceEdgeGroup = "SERVICE_EDGE_GRP"
+
+// ResolveReferences of this ProvisioningKey
+func (mg *ProvisioningKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.enrollmentCertID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.EnrollmentCertID,
+		Reference:    mg.Spec.ForProvider.EnrollmentCertRef,
+		Selector:     mg.Spec.ForProvider.EnrollmentCertSelector,
+		To:           reference.To{Managed: &enrollmentCert.EnrollmentCert{}, List: &enrollmentCert.EnrollmentCertList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.enrollmentCertID")
+	}
+	mg.Spec.ForProvider.EnrollmentCertID = rsp.ResolvedValue
+	mg.Spec.ForProvider.EnrollmentCertRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.zComponentID of SERVICE_EDGE_GRP keys
+	if mg.Spec.ForProvider.AssociationType == associationTypeServiceEdgeGroup {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ZComponentID),
+			Reference:    mg.Spec.ForProvider.ServiceEdgeGroupRef,
+			Selector:     mg.Spec.ForProvider.ServiceEdgeGroupSelector,
+			To:           reference.To{Managed: &serviceEdgeGroup.ServiceEdgeGroup{}, List: &serviceEdgeGroup.ServiceEdgeGroupList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.zComponentID")
+		}
+		mg.Spec.ForProvider.ZComponentID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.ServiceEdgeGroupRef = rsp.ResolvedReference
+
+		return nil
+	}
+
+	// Resolve spec.forProvider.zComponentID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ZComponentID),
+		Reference:    mg.Spec.ForProvider.AppConnectorGroupRef,
+		Selector:     mg.Spec.ForProvider.AppConnectorGroupSelector,
+		To:           reference.To{Managed: &appConnectorGroup.AppConnectorGroup{}, List: &appConnectorGroup.AppConnectorGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zComponentID")
+	}
+	mg.Spec.ForProvider.ZComponentID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AppConnectorGroupRef = rsp.ResolvedReference
+
+	return nil
+}
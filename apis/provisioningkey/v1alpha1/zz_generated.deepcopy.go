@@ -0,0 +1,205 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomProvisioningKeyParameters) DeepCopyInto(out *CustomProvisioningKeyParameters) {
+	*out = *in
+	if in.AppConnectorGroupRef != nil {
+		in, out := &in.AppConnectorGroupRef, &out.AppConnectorGroupRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.AppConnectorGroupSelector != nil {
+		in, out := &in.AppConnectorGroupSelector, &out.AppConnectorGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceEdgeGroupRef != nil {
+		in, out := &in.ServiceEdgeGroupRef, &out.ServiceEdgeGroupRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceEdgeGroupSelector != nil {
+		in, out := &in.ServiceEdgeGroupSelector, &out.ServiceEdgeGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnrollmentCertRef != nil {
+		in, out := &in.EnrollmentCertRef, &out.EnrollmentCertRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.EnrollmentCertSelector != nil {
+		in, out := &in.EnrollmentCertSelector, &out.EnrollmentCertSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomProvisioningKeyParameters.
+func (in *CustomProvisioningKeyParameters) DeepCopy() *CustomProvisioningKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomProvisioningKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningKey) DeepCopyInto(out *ProvisioningKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningKey.
+func (in *ProvisioningKey) DeepCopy() *ProvisioningKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningKeyList) DeepCopyInto(out *ProvisioningKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProvisioningKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningKeyList.
+func (in *ProvisioningKeyList) DeepCopy() *ProvisioningKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningKeyParameters) DeepCopyInto(out *ProvisioningKeyParameters) {
+	*out = *in
+	in.CustomProvisioningKeyParameters.DeepCopyInto(&out.CustomProvisioningKeyParameters)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ZComponentID != nil {
+		in, out := &in.ZComponentID, &out.ZComponentID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningKeyParameters.
+func (in *ProvisioningKeyParameters) DeepCopy() *ProvisioningKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningKeySpec) DeepCopyInto(out *ProvisioningKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningKeySpec.
+func (in *ProvisioningKeySpec) DeepCopy() *ProvisioningKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningKeyStatus) DeepCopyInto(out *ProvisioningKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningKeyStatus.
+func (in *ProvisioningKeyStatus) DeepCopy() *ProvisioningKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SAMLAttributeParameters defines the SAML attribute that a SAMLAttribute
+// looks up.
+type SAMLAttributeParameters struct {
+	// Name of the SAML attribute in ZPA, e.g. Email_Okta.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A SAMLAttributeSpec defines the desired state of a SAMLAttribute.
+type SAMLAttributeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLAttributeParameters `json:"forProvider"`
+}
+
+// A SAMLAttributeStatus represents the status of a SAMLAttribute.
+type SAMLAttributeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a SAMLAttribute.
+type Observation struct {
+	CreationTime  string `json:"creationTime,omitempty"`
+	ModifiedBy    string `json:"modifiedBy,omitempty"`
+	ModifiedTime  string `json:"modifiedTime,omitempty"`
+	ID            string `json:"id,omitempty"`
+	IdpID         string `json:"idpID,omitempty"`
+	IdpName       string `json:"idpName,omitempty"`
+	SamlName      string `json:"samlName,omitempty"`
+	UserAttribute bool   `json:"userAttribute,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SAMLAttribute looks up a SAML attribute of an identity provider of ZPA
+// by name. SAML attributes are configured in the ZPA admin portal, so a
+// SAMLAttribute never creates, updates or deletes one. Its external name is
+// set to the ID of the SAML attribute, so that other resources can reference
+// it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IDP",type="string",JSONPath=".status.atProvider.idpName"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type SAMLAttribute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SAMLAttributeSpec   `json:"spec"`
+	Status SAMLAttributeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLAttributeList contains a list of SAMLAttribute
+type SAMLAttributeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLAttribute `json:"items"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	idp "github.com/crossplane-contrib/provider-zpa/apis/idp/v1alpha1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this SCIMGroup
+func (mg *SCIMGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.idpID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IdpID),
+		Reference:    mg.Spec.ForProvider.IdpIDRef,
+		Selector:     mg.Spec.ForProvider.IdpIDSelector,
+		To:           reference.To{Managed: &idp.Idp{}, List: &idp.IdpList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.idpID")
+	}
+	mg.Spec.ForProvider.IdpID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IdpIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomSCIMGroupParameters that are not part of the ZPA API
+type CustomSCIMGroupParameters struct {
+	// IdpIDRef is a reference to a Idp so set external ID
+	// +optional
+	IdpIDRef *xpv1.Reference `json:"idpIDRef,omitempty"`
+
+	// IdpIDSelector selects a reference to a Idp so set external ID
+	// +optional
+	IdpIDSelector *xpv1.Selector `json:"idpIDSelector,omitempty"`
+}
+
+// A SCIMGroupParameters defines the SCIM group that a SCIMGroup looks up.
+type SCIMGroupParameters struct {
+	CustomSCIMGroupParameters `json:",inline"`
+
+	// Name of the SCIM group in ZPA.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// IdpID is the ID of the identity provider that provisions the SCIM
+	// group.
+	// +optional
+	IdpID *string `json:"idpID,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A SCIMGroupSpec defines the desired state of a SCIMGroup.
+type SCIMGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SCIMGroupParameters `json:"forProvider"`
+}
+
+// A SCIMGroupStatus represents the status of a SCIMGroup.
+type SCIMGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a SCIMGroup.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	IdpGroupID   string `json:"idpGroupID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SCIMGroup looks up a SCIM group of an identity provider of ZPA by name.
+// SCIM groups are provisioned by the identity provider, so a SCIMGroup never
+// creates, updates or deletes one. Its external name is set to the ID of the
+// SCIM group, so that other resources can reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type SCIMGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SCIMGroupSpec   `json:"spec"`
+	Status SCIMGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SCIMGroupList contains a list of SCIMGroup
+type SCIMGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SCIMGroup `json:"items"`
+}
@@ -0,0 +1,180 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomSCIMGroupParameters) DeepCopyInto(out *CustomSCIMGroupParameters) {
+	*out = *in
+	if in.IdpIDRef != nil {
+		in, out := &in.IdpIDRef, &out.IdpIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IdpIDSelector != nil {
+		in, out := &in.IdpIDSelector, &out.IdpIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSCIMGroupParameters.
+func (in *CustomSCIMGroupParameters) DeepCopy() *CustomSCIMGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomSCIMGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCIMGroup) DeepCopyInto(out *SCIMGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCIMGroup.
+func (in *SCIMGroup) DeepCopy() *SCIMGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SCIMGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SCIMGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCIMGroupList) DeepCopyInto(out *SCIMGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SCIMGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCIMGroupList.
+func (in *SCIMGroupList) DeepCopy() *SCIMGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SCIMGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SCIMGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCIMGroupParameters) DeepCopyInto(out *SCIMGroupParameters) {
+	*out = *in
+	in.CustomSCIMGroupParameters.DeepCopyInto(&out.CustomSCIMGroupParameters)
+	if in.IdpID != nil {
+		in, out := &in.IdpID, &out.IdpID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCIMGroupParameters.
+func (in *SCIMGroupParameters) DeepCopy() *SCIMGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SCIMGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCIMGroupSpec) DeepCopyInto(out *SCIMGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCIMGroupSpec.
+func (in *SCIMGroupSpec) DeepCopy() *SCIMGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SCIMGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCIMGroupStatus) DeepCopyInto(out *SCIMGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCIMGroupStatus.
+func (in *SCIMGroupStatus) DeepCopy() *SCIMGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SCIMGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
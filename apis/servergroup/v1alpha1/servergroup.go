@@ -19,8 +19,20 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// CustomServerGroupParameters that are not part of the ZPA API
+type CustomServerGroupParameters struct {
+	// AppConnectorGroupsRefs is a reference to a AppConnectorGroups so set external ID
+	// +optional
+	AppConnectorGroupsRefs []xpv1.Reference `json:"appConnectorGroupsRefs,omitempty"`
+
+	// AppConnectorGroupsSelector selects a reference to a AppConnectorGroups so set external ID
+	// +optional
+	AppConnectorGroupsSelector *xpv1.Selector `json:"appConnectorGroupsSelector,omitempty"`
+}
+
 // A ServerGroupParameters defines desired state of a ServerSegment
 type ServerGroupParameters struct {
+	CustomServerGroupParameters `json:",inline"`
 
 	// enabled
 	Enabled *bool `json:"enabled,omitempty"`
@@ -39,8 +51,8 @@ type ServerGroupParameters struct {
 	DynamicDiscovery bool `json:"dynamicDiscovery"`
 
 	// app connector groups
-	// +required
-	AppConnectorGroups []string `json:"appConnectorGroups"`
+	// +optional
+	AppConnectorGroups []string `json:"appConnectorGroups,omitempty"`
 
 	// CustomerID The unique identifier of the ZPA tenant.
 	// +kubebuilder:validation:Required
@@ -21,9 +21,35 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomServerGroupParameters) DeepCopyInto(out *CustomServerGroupParameters) {
+	*out = *in
+	if in.AppConnectorGroupsRefs != nil {
+		in, out := &in.AppConnectorGroupsRefs, &out.AppConnectorGroupsRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppConnectorGroupsSelector != nil {
+		in, out := &in.AppConnectorGroupsSelector, &out.AppConnectorGroupsSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomServerGroupParameters.
+func (in *CustomServerGroupParameters) DeepCopy() *CustomServerGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomServerGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Observation) DeepCopyInto(out *Observation) {
 	*out = *in
@@ -101,6 +127,7 @@ func (in *ServerGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroupParameters) DeepCopyInto(out *ServerGroupParameters) {
 	*out = *in
+	in.CustomServerGroupParameters.DeepCopyInto(&out.CustomServerGroupParameters)
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
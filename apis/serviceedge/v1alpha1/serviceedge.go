@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomServiceEdgeParameters that are not part of the ZPA API
+type CustomServiceEdgeParameters struct {
+	// ServiceEdgeGroupIDRef is a reference to a ServiceEdgeGroupID so set external ID
+	// +optional
+	ServiceEdgeGroupIDRef *xpv1.Reference `json:"serviceEdgeGroupIDRef,omitempty"`
+
+	// ServiceEdgeGroupIDSelector selects a reference to a ServiceEdgeGroupID so set external ID
+	// +optional
+	ServiceEdgeGroupIDSelector *xpv1.Selector `json:"serviceEdgeGroupIDSelector,omitempty"`
+}
+
+// A ServiceEdgeParameters defines desired state of a ServiceEdge
+type ServiceEdgeParameters struct {
+	CustomServiceEdgeParameters `json:",inline"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// service edge group id
+	ServiceEdgeGroupID *string `json:"serviceEdgeGroupID,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A ServiceEdgeSpec defines the desired state of a ServiceEdge.
+type ServiceEdgeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceEdgeParameters `json:"forProvider"`
+}
+
+// A ServiceEdgeStatus represents the status of a ServiceEdge.
+type ServiceEdgeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a ServiceEdge.
+type Observation struct {
+	CreationTime             string `json:"creationTime,omitempty"`
+	ModifiedBy               string `json:"modifiedBy,omitempty"`
+	ModifiedTime             string `json:"modifiedTime,omitempty"`
+	ID                       string `json:"id,omitempty"`
+	ServiceEdgeGroupName     string `json:"serviceEdgeGroupName,omitempty"`
+	ControlChannelStatus     string `json:"controlChannelStatus,omitempty"`
+	CtrlBrokerName           string `json:"ctrlBrokerName,omitempty"`
+	CurrentVersion           string `json:"currentVersion,omitempty"`
+	ExpectedVersion          string `json:"expectedVersion,omitempty"`
+	UpgradeStatus            string `json:"upgradeStatus,omitempty"`
+	LastBrokerConnectTime    string `json:"lastBrokerConnectTime,omitempty"`
+	LastBrokerDisconnectTime string `json:"lastBrokerDisconnectTime,omitempty"`
+	Platform                 string `json:"platform,omitempty"`
+	PrivateIP                string `json:"privateIP,omitempty"`
+	PublicIP                 string `json:"publicIP,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceEdge is the schema for ZPA ServiceEdges API. Service Edges
+// enroll themselves using a provisioning key, so an existing Service Edge is
+// adopted by setting the external name annotation to its ID. Deleting a
+// ServiceEdge deletes the Service Edge from ZPA, unless its deletion policy is
+// Orphan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.controlChannelStatus"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.currentVersion",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type ServiceEdge struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceEdgeSpec   `json:"spec"`
+	Status ServiceEdgeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceEdgeList contains a list of ServiceEdge
+type ServiceEdgeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceEdge `json:"items"`
+}
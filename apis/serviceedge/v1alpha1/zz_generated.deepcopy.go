@@ -0,0 +1,185 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomServiceEdgeParameters) DeepCopyInto(out *CustomServiceEdgeParameters) {
+	*out = *in
+	if in.ServiceEdgeGroupIDRef != nil {
+		in, out := &in.ServiceEdgeGroupIDRef, &out.ServiceEdgeGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceEdgeGroupIDSelector != nil {
+		in, out := &in.ServiceEdgeGroupIDSelector, &out.ServiceEdgeGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomServiceEdgeParameters.
+func (in *CustomServiceEdgeParameters) DeepCopy() *CustomServiceEdgeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomServiceEdgeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observation) DeepCopyInto(out *Observation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observation.
+func (in *Observation) DeepCopy() *Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(Observation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEdge) DeepCopyInto(out *ServiceEdge) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEdge.
+func (in *ServiceEdge) DeepCopy() *ServiceEdge {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEdge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceEdge) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEdgeList) DeepCopyInto(out *ServiceEdgeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceEdge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEdgeList.
+func (in *ServiceEdgeList) DeepCopy() *ServiceEdgeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEdgeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceEdgeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEdgeParameters) DeepCopyInto(out *ServiceEdgeParameters) {
+	*out = *in
+	in.CustomServiceEdgeParameters.DeepCopyInto(&out.CustomServiceEdgeParameters)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceEdgeGroupID != nil {
+		in, out := &in.ServiceEdgeGroupID, &out.ServiceEdgeGroupID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEdgeParameters.
+func (in *ServiceEdgeParameters) DeepCopy() *ServiceEdgeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEdgeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEdgeSpec) DeepCopyInto(out *ServiceEdgeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEdgeSpec.
+func (in *ServiceEdgeSpec) DeepCopy() *ServiceEdgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEdgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEdgeStatus) DeepCopyInto(out *ServiceEdgeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEdgeStatus.
+func (in *ServiceEdgeStatus) DeepCopy() *ServiceEdgeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEdgeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
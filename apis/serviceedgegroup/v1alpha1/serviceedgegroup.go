@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ServiceEdgeGroupParameters defines desired state of a ServiceEdgeGroup
+type ServiceEdgeGroupParameters struct {
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// city and country of the location, e.g. San Jose, US
+	CityCountry string `json:"cityCountry,omitempty"`
+
+	// country code of the location, e.g. US
+	CountryCode string `json:"countryCode,omitempty"`
+
+	// location, e.g. San Jose, CA, USA
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// latitude of the location
+	// +kubebuilder:validation:Required
+	Latitude string `json:"latitude"`
+
+	// longitude of the location
+	// +kubebuilder:validation:Required
+	Longitude string `json:"longitude"`
+
+	// version profile id, 0 is Default, 1 is Previous Default and 2 is New
+	// Release
+	VersionProfileID string `json:"versionProfileID,omitempty"`
+
+	// override version profile
+	OverrideVersionProfile *bool `json:"overrideVersionProfile,omitempty"`
+
+	// IsPublic marks the service edge group as public rather than private.
+	IsPublic *bool `json:"isPublic,omitempty"`
+
+	// TrustedNetworks are the IDs of the trusted networks from which users
+	// connect through the service edge group.
+	// +optional
+	TrustedNetworks []string `json:"trustedNetworks,omitempty"`
+
+	// upgrade day
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	UpgradeDay string `json:"upgradeDay,omitempty"`
+
+	// upgrade time in secs after midnight
+	UpgradeTimeInSecs string `json:"upgradeTimeInSecs,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A ServiceEdgeGroupSpec defines the desired state of a ServiceEdgeGroup.
+type ServiceEdgeGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceEdgeGroupParameters `json:"forProvider"`
+}
+
+// A ServiceEdgeGroupStatus represents the status of a ServiceEdgeGroup.
+type ServiceEdgeGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a ServiceEdgeGroup.
+type Observation struct {
+	CreationTime       string `json:"creationTime,omitempty"`
+	ModifiedBy         string `json:"modifiedBy,omitempty"`
+	ModifiedTime       string `json:"modifiedTime,omitempty"`
+	ID                 string `json:"id,omitempty"`
+	VersionProfileName string `json:"versionProfileName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceEdgeGroup is the schema for ZPA ServiceEdgeGroups API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type ServiceEdgeGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceEdgeGroupSpec   `json:"spec"`
+	Status ServiceEdgeGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceEdgeGroupList contains a list of ServiceEdgeGroup
+type ServiceEdgeGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceEdgeGroup `json:"items"`
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+// A TimeoutPolicyRuleParameters defines desired state of a TimeoutPolicyRule
+type TimeoutPolicyRuleParameters struct {
+	// ReauthTimeout is the time in seconds after which users have to
+	// authenticate again, or -1 for never.
+	// +kubebuilder:validation:Required
+	ReauthTimeout int32 `json:"reauthTimeout"`
+
+	// ReauthIdleTimeout is the time in seconds after which idle connections
+	// require users to authenticate again, or -1 for never.
+	// +kubebuilder:validation:Required
+	ReauthIdleTimeout int32 `json:"reauthIdleTimeout"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// custom msg
+	CustomMsg string `json:"customMsg,omitempty"`
+
+	// operator
+	// +kubebuilder:validation:Enum=AND;OR
+	Operator string `json:"operator,omitempty"`
+
+	// RuleOrder is the position of the rule in the timeout policy, starting
+	// at 1. Rules are evaluated in order.
+	// +kubebuilder:validation:Minimum=1
+	RuleOrder *int32 `json:"ruleOrder,omitempty"`
+
+	// conditions
+	Conditions []common.PolicyRuleCondition `json:"conditions,omitempty"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A TimeoutPolicyRuleSpec defines the desired state of a TimeoutPolicyRule.
+type TimeoutPolicyRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TimeoutPolicyRuleParameters `json:"forProvider"`
+}
+
+// A TimeoutPolicyRuleStatus represents the status of a TimeoutPolicyRule.
+type TimeoutPolicyRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a TimeoutPolicyRule.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	PolicySetID  string `json:"policySetID,omitempty"`
+	Priority     int32  `json:"priority,omitempty"`
+	RuleOrder    int32  `json:"ruleOrder,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TimeoutPolicyRule is the schema for ZPA TimeoutPolicyRules API. Its rule
+// is added to the timeout policy set of the customer.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TIMEOUT",type="integer",JSONPath=".spec.forProvider.reauthTimeout"
+// +kubebuilder:printcolumn:name="IDLE-TIMEOUT",type="integer",JSONPath=".spec.forProvider.reauthIdleTimeout"
+// +kubebuilder:printcolumn:name="ORDER",type="integer",JSONPath=".spec.forProvider.ruleOrder",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type TimeoutPolicyRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TimeoutPolicyRuleSpec   `json:"spec"`
+	Status TimeoutPolicyRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TimeoutPolicyRuleList contains a list of TimeoutPolicyRule
+type TimeoutPolicyRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TimeoutPolicyRule `json:"items"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A TrustedNetworkParameters defines the trusted network that a TrustedNetwork looks
+// up.
+type TrustedNetworkParameters struct {
+	// Name of the trusted network in ZPA.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CustomerID The unique identifier of the ZPA tenant.
+	// +kubebuilder:validation:Required
+	CustomerID string `json:"customerID"`
+}
+
+// A TrustedNetworkSpec defines the desired state of a TrustedNetwork.
+type TrustedNetworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrustedNetworkParameters `json:"forProvider"`
+}
+
+// A TrustedNetworkStatus represents the status of a TrustedNetwork.
+type TrustedNetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          Observation `json:"atProvider,omitempty"`
+}
+
+// Observation are the observable fields of a TrustedNetwork.
+type Observation struct {
+	CreationTime string `json:"creationTime,omitempty"`
+	ModifiedBy   string `json:"modifiedBy,omitempty"`
+	ModifiedTime string `json:"modifiedTime,omitempty"`
+	ID           string `json:"id,omitempty"`
+	NetworkID    string `json:"networkID,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+	ZscalerCloud string `json:"zscalerCloud,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrustedNetwork looks up a trusted network of ZPA by name. Trusted
+// networks are configured in the ZPA admin portal, so a TrustedNetwork never
+// creates, updates or deletes one. Its external name is set to the ID of the
+// trusted network and its network ID is observed, so that policy rules can
+// reference it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NETWORK-ID",type="string",JSONPath=".status.atProvider.networkID"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,zpa}
+type TrustedNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrustedNetworkSpec   `json:"spec"`
+	Status TrustedNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrustedNetworkList contains a list of TrustedNetwork
+type TrustedNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrustedNetwork `json:"items"`
+}
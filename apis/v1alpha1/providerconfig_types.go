@@ -37,11 +37,67 @@ type ProviderConfigSpec struct {
 	// Basepath of the ZPA API. Defaults to "/"
 	// +optional
 	Basepath *string `json:"basepath,omitempty"`
+
+	// Debug logs all requests to and responses from the ZPA API, with
+	// credentials redacted. The log entries are only written if the provider
+	// runs with --debug.
+	// +optional
+	Debug bool `json:"debug,omitempty"`
+
+	// ProxyURL of the HTTP(S) proxy the ZPA API is reached through, e.g.
+	// "http://proxy.example.com:3128". Defaults to the proxy configured by
+	// the HTTPS_PROXY and NO_PROXY environment variables of the provider.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+
+	// Timeout of a single request to the ZPA API, e.g. "30s". Defaults to no
+	// timeout.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// TLS configures how connections to the ZPA API are secured.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// RateLimit of requests to the ZPA API. The limit applies per ZPA
+	// tenant, i.e. host and customer ID, and is shared by all managed
+	// resources of the tenant.
+	// +optional
+	RateLimit *RateLimitConfig `json:"rateLimit,omitempty"`
+}
+
+// RateLimitConfig configures the rate of requests to the ZPA API.
+type RateLimitConfig struct {
+	// RequestsPerSecond that may be sent to the ZPA API. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestsPerSecond *int `json:"requestsPerSecond,omitempty"`
+
+	// Burst of requests that may exceed RequestsPerSecond. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int `json:"burst,omitempty"`
+}
+
+// TLSConfig configures how connections to the ZPA API are secured.
+type TLSConfig struct {
+	// CABundleSecretRef references a PEM encoded bundle of CA certificates
+	// that are trusted in addition to the system roots, e.g. the CA of a TLS
+	// inspecting proxy.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// ClientCertificateSecretRef references a kubernetes.io/tls Secret whose
+	// tls.crt and tls.key are presented as client certificate.
+	// +optional
+	ClientCertificateSecretRef *xpv1.SecretReference `json:"clientCertificateSecretRef,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
-	// Source of the provider credentials.
+	// Source of the provider credentials. InjectedIdentity reads the
+	// ClientID and ClientSecret from the ZPA_CLIENT_ID and ZPA_CLIENT_SECRET
+	// environment variables of the provider.
 	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
 	Source xpv1.CredentialsSource `json:"source"`
 
@@ -20,10 +20,31 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	accessPolicyRulev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/accesspolicyrule/v1alpha1"
+	appConnectorv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/appconnector/v1alpha1"
+	appConnectorGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/appconnectorgroup/v1alpha1"
 	applicationSegmentv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/applicationsegment/v1alpha1"
+	baCertificatev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/bacertificate/v1alpha1"
+	clientForwardingPolicyRulev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/clientforwardingpolicyrule/v1alpha1"
+	enrollmentCertv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/enrollmentcert/v1alpha1"
+	idpv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/idp/v1alpha1"
+	inspectionCustomControlv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectioncustomcontrol/v1alpha1"
+	inspectionPolicyRulev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectionpolicyrule/v1alpha1"
+	inspectionProfilev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectionprofile/v1alpha1"
+	isolationPolicyRulev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/isolationpolicyrule/v1alpha1"
+	lssConfigv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/lssconfig/v1alpha1"
+	machineGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/machinegroup/v1alpha1"
+	postureProfilev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/postureprofile/v1alpha1"
+	provisioningKeyv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/provisioningkey/v1alpha1"
+	samlAttributev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/samlattribute/v1alpha1"
+	scimGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/scimgroup/v1alpha1"
 	segmentGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/segmentgroup/v1alpha1"
 	serverv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/server/v1alpha1"
 	serverGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/servergroup/v1alpha1"
+	serviceEdgev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedge/v1alpha1"
+	serviceEdgeGroupv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedgegroup/v1alpha1"
+	timeoutPolicyRulev1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/timeoutpolicyrule/v1alpha1"
+	trustedNetworkv1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/trustednetwork/v1alpha1"
 	zpav1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
 )
 
@@ -35,6 +56,27 @@ func init() {
 		segmentGroupv1alpha1.SchemeBuilder.AddToScheme,
 		serverv1alpha1.SchemeBuilder.AddToScheme,
 		serverGroupv1alpha1.SchemeBuilder.AddToScheme,
+		appConnectorGroupv1alpha1.SchemeBuilder.AddToScheme,
+		appConnectorv1alpha1.SchemeBuilder.AddToScheme,
+		provisioningKeyv1alpha1.SchemeBuilder.AddToScheme,
+		accessPolicyRulev1alpha1.SchemeBuilder.AddToScheme,
+		timeoutPolicyRulev1alpha1.SchemeBuilder.AddToScheme,
+		clientForwardingPolicyRulev1alpha1.SchemeBuilder.AddToScheme,
+		serviceEdgeGroupv1alpha1.SchemeBuilder.AddToScheme,
+		serviceEdgev1alpha1.SchemeBuilder.AddToScheme,
+		idpv1alpha1.SchemeBuilder.AddToScheme,
+		samlAttributev1alpha1.SchemeBuilder.AddToScheme,
+		scimGroupv1alpha1.SchemeBuilder.AddToScheme,
+		postureProfilev1alpha1.SchemeBuilder.AddToScheme,
+		trustedNetworkv1alpha1.SchemeBuilder.AddToScheme,
+		baCertificatev1alpha1.SchemeBuilder.AddToScheme,
+		inspectionCustomControlv1alpha1.SchemeBuilder.AddToScheme,
+		inspectionProfilev1alpha1.SchemeBuilder.AddToScheme,
+		inspectionPolicyRulev1alpha1.SchemeBuilder.AddToScheme,
+		isolationPolicyRulev1alpha1.SchemeBuilder.AddToScheme,
+		lssConfigv1alpha1.SchemeBuilder.AddToScheme,
+		machineGroupv1alpha1.SchemeBuilder.AddToScheme,
+		enrollmentCertv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -37,6 +37,7 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		metricsAddress = app.Flag("metrics-bind-address", "Address the Prometheus metrics endpoint binds to, or 0 to disable it.").Default(":8080").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -55,9 +56,10 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		LeaderElection:   *leaderElection,
-		LeaderElectionID: "crossplane-leader-election-provider-zpa",
-		SyncPeriod:       syncPeriod,
+		LeaderElection:     *leaderElection,
+		LeaderElectionID:   "crossplane-leader-election-provider-zpa",
+		SyncPeriod:         syncPeriod,
+		MetricsBindAddress: *metricsAddress,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
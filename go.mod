@@ -7,9 +7,14 @@ require (
 	github.com/crossplane/crossplane-tools v0.0.0-20210916125540-071de511ae8e
 	github.com/go-openapi/runtime v0.20.0
 	github.com/go-openapi/strfmt v0.20.3
+	github.com/golang/mock v1.6.0
 	github.com/google/go-cmp v0.5.6
 	github.com/haarchri/zpa-go-client v0.0.11
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
+	github.com/prometheus/client_model v0.2.0
+	github.com/spf13/afero v1.6.0
+	golang.org/x/time v0.0.0-20210723032227-1f47c861a9ac
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6
 	k8s.io/api v0.21.3
 	k8s.io/apimachinery v0.21.3
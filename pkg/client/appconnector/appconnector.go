@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package appconnector is a client of the App Connector API of ZPA. App
+// Connectors enroll themselves using a provisioning key, so they can only be
+// read, updated and deleted.
+package appconnector
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const pathObject = "/mgmtconfig/v1/admin/customers/{customerId}/connector/{connectorId}"
+
+// ClientService is the interface of the App Connector API.
+type ClientService interface {
+	GetAppConnector(params *GetAppConnectorParams) (*models.Connector, error)
+	UpdateAppConnector(params *UpdateAppConnectorParams) error
+	DeleteAppConnector(params *DeleteAppConnectorParams) error
+}
+
+// GetAppConnectorParams are the parameters of GetAppConnector.
+type GetAppConnectorParams struct {
+	Context     context.Context
+	CustomerID  string
+	ConnectorID string
+}
+
+// UpdateAppConnectorParams are the parameters of UpdateAppConnector.
+type UpdateAppConnectorParams struct {
+	Context     context.Context
+	CustomerID  string
+	ConnectorID string
+	Connector   *models.Connector
+}
+
+// DeleteAppConnectorParams are the parameters of DeleteAppConnector.
+type DeleteAppConnectorParams struct {
+	Context     context.Context
+	CustomerID  string
+	ConnectorID string
+}
+
+// New creates a client of the App Connector API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the App Connector API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetAppConnector gets an App Connector.
+func (c *Client) GetAppConnector(params *GetAppConnectorParams) (*models.Connector, error) {
+	out := &models.Connector{}
+	err := operation.Submit(params.Context, c.transport, "getAppConnectorUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "connectorId": params.ConnectorID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateAppConnector updates an App Connector.
+func (c *Client) UpdateAppConnector(params *UpdateAppConnectorParams) error {
+	return operation.Submit(params.Context, c.transport, "updateAppConnectorUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "connectorId": params.ConnectorID},
+		Body: params.Connector,
+	}, nil)
+}
+
+// DeleteAppConnector deletes an App Connector.
+func (c *Client) DeleteAppConnector(params *DeleteAppConnectorParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteAppConnectorUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "connectorId": params.ConnectorID},
+	}, nil)
+}
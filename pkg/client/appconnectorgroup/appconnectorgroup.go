@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package appconnectorgroup is a client of the App Connector Group API of
+// ZPA. The connector_group_controller of zpa-go-client can only read them.
+package appconnectorgroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathCollection = "/mgmtconfig/v1/admin/customers/{customerId}/appConnectorGroup"
+	pathObject     = pathCollection + "/{appConnectorGroupId}"
+)
+
+// ClientService is the interface of the App Connector Group API.
+type ClientService interface {
+	GetAppConnectorGroup(params *GetAppConnectorGroupParams) (*models.AppConnectorGroup, error)
+	AddAppConnectorGroup(params *AddAppConnectorGroupParams) (*models.AppConnectorGroup, error)
+	UpdateAppConnectorGroup(params *UpdateAppConnectorGroupParams) error
+	DeleteAppConnectorGroup(params *DeleteAppConnectorGroupParams) error
+}
+
+// GetAppConnectorGroupParams are the parameters of GetAppConnectorGroup.
+type GetAppConnectorGroupParams struct {
+	Context             context.Context
+	CustomerID          string
+	AppConnectorGroupID string
+}
+
+// AddAppConnectorGroupParams are the parameters of AddAppConnectorGroup.
+type AddAppConnectorGroupParams struct {
+	Context           context.Context
+	CustomerID        string
+	AppConnectorGroup *models.AppConnectorGroup
+}
+
+// UpdateAppConnectorGroupParams are the parameters of
+// UpdateAppConnectorGroup.
+type UpdateAppConnectorGroupParams struct {
+	Context             context.Context
+	CustomerID          string
+	AppConnectorGroupID string
+	AppConnectorGroup   *models.AppConnectorGroup
+}
+
+// DeleteAppConnectorGroupParams are the parameters of
+// DeleteAppConnectorGroup.
+type DeleteAppConnectorGroupParams struct {
+	Context             context.Context
+	CustomerID          string
+	AppConnectorGroupID string
+}
+
+// New creates a client of the App Connector Group API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the App Connector Group API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetAppConnectorGroup gets an App Connector Group.
+func (c *Client) GetAppConnectorGroup(params *GetAppConnectorGroupParams) (*models.AppConnectorGroup, error) {
+	out := &models.AppConnectorGroup{}
+	err := operation.Submit(params.Context, c.transport, "getAppConnectorGroupUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "appConnectorGroupId": params.AppConnectorGroupID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddAppConnectorGroup adds an App Connector Group.
+func (c *Client) AddAppConnectorGroup(params *AddAppConnectorGroupParams) (*models.AppConnectorGroup, error) {
+	out := &models.AppConnectorGroup{}
+	err := operation.Submit(params.Context, c.transport, "addAppConnectorGroupUsingPOST", http.MethodPost, pathCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.AppConnectorGroup,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateAppConnectorGroup updates an App Connector Group.
+func (c *Client) UpdateAppConnectorGroup(params *UpdateAppConnectorGroupParams) error {
+	return operation.Submit(params.Context, c.transport, "updateAppConnectorGroupUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "appConnectorGroupId": params.AppConnectorGroupID},
+		Body: params.AppConnectorGroup,
+	}, nil)
+}
+
+// DeleteAppConnectorGroup deletes an App Connector Group.
+func (c *Client) DeleteAppConnectorGroup(params *DeleteAppConnectorGroupParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteAppConnectorGroupUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "appConnectorGroupId": params.AppConnectorGroupID},
+	}, nil)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bacertificate is a client of the certificate API of ZPA, whose
+// certificates browser access applications are served with. zpa-go-client
+// only covers reading them.
+package bacertificate
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathCollection = "/mgmtconfig/v1/admin/customers/{customerId}/certificate"
+	pathObject     = pathCollection + "/{certificateId}"
+)
+
+// A BACertificate of the ZPA API. CertBlob holds the PEM encoded certificate
+// and private key that are uploaded; it is never returned. Certificate holds
+// the PEM encoded certificate that was uploaded.
+type BACertificate struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description,omitempty"`
+	CertBlob            string   `json:"certBlob,omitempty"`
+	Certificate         string   `json:"certificate,omitempty"`
+	CName               string   `json:"cName,omitempty"`
+	IssuedBy            string   `json:"issuedBy,omitempty"`
+	IssuedTo            string   `json:"issuedTo,omitempty"`
+	San                 []string `json:"san,omitempty"`
+	SerialNo            string   `json:"serialNo,omitempty"`
+	Status              string   `json:"status,omitempty"`
+	ValidFromInEpochSec string   `json:"validFromInEpochSec,omitempty"`
+	ValidToInEpochSec   string   `json:"validToInEpochSec,omitempty"`
+	CreationTime        string   `json:"creationTime,omitempty"`
+	ModifiedBy          string   `json:"modifiedBy,omitempty"`
+	ModifiedTime        string   `json:"modifiedTime,omitempty"`
+}
+
+// ClientService is the interface of the certificate API.
+type ClientService interface {
+	GetBACertificate(params *GetBACertificateParams) (*BACertificate, error)
+	AddBACertificate(params *AddBACertificateParams) (*BACertificate, error)
+	DeleteBACertificate(params *DeleteBACertificateParams) error
+}
+
+// GetBACertificateParams are the parameters of GetBACertificate.
+type GetBACertificateParams struct {
+	Context       context.Context
+	CustomerID    string
+	CertificateID string
+}
+
+// AddBACertificateParams are the parameters of AddBACertificate.
+type AddBACertificateParams struct {
+	Context       context.Context
+	CustomerID    string
+	BACertificate *BACertificate
+}
+
+// DeleteBACertificateParams are the parameters of DeleteBACertificate.
+type DeleteBACertificateParams struct {
+	Context       context.Context
+	CustomerID    string
+	CertificateID string
+}
+
+// New creates a client of the certificate API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the certificate API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetBACertificate gets a certificate.
+func (c *Client) GetBACertificate(params *GetBACertificateParams) (*BACertificate, error) {
+	out := &BACertificate{}
+	err := operation.Submit(params.Context, c.transport, "getCertificateUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "certificateId": params.CertificateID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddBACertificate uploads a certificate and its private key.
+func (c *Client) AddBACertificate(params *AddBACertificateParams) (*BACertificate, error) {
+	out := &BACertificate{}
+	err := operation.Submit(params.Context, c.transport, "addCertificateUsingPOST", http.MethodPost, pathCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.BACertificate,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteBACertificate deletes a certificate.
+func (c *Client) DeleteBACertificate(params *DeleteBACertificateParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteCertificateUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "certificateId": params.CertificateID},
+	}, nil)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+const (
+	// EnvClientID is the environment variable of the provider pod the ClientID
+	// is read from when its source is InjectedIdentity.
+	EnvClientID = "ZPA_CLIENT_ID"
+
+	// EnvClientSecret is the environment variable of the provider pod the
+	// ClientSecret is read from when its source is InjectedIdentity.
+	EnvClientSecret = "ZPA_CLIENT_SECRET"
+)
+
+const (
+	errUnsupportedSource = "unsupported credentials source %q"
+	errEmptyCredentials  = "credentials extracted from source %q are empty"
+)
+
+type providerCredentials struct {
+	token string
+}
+
+// A credentialsExtractor extracts the credentials of a ProviderConfig from
+// any of the sources supported by crossplane-runtime.
+type credentialsExtractor struct {
+	client client.Client
+	env    resource.EnvLookupFn
+	fs     afero.Fs
+}
+
+func newCredentialsExtractor(c client.Client) *credentialsExtractor {
+	return &credentialsExtractor{client: c, env: os.Getenv, fs: afero.NewOsFs()}
+}
+
+// Extract the supplied credentials. Credentials with source InjectedIdentity
+// are read from the supplied environment variable of the provider pod.
+func (e *credentialsExtractor) Extract(ctx context.Context, pc v1alpha1.ProviderCredentials, injectedEnv string) (*providerCredentials, error) {
+	var token []byte
+	var err error
+
+	switch pc.Source { // nolint:exhaustive
+	case xpv1.CredentialsSourceSecret:
+		return extractCredentialsFromSecret(ctx, e.client, pc.CommonCredentialSelectors)
+	case xpv1.CredentialsSourceEnvironment:
+		token, err = resource.ExtractEnv(ctx, e.env, pc.CommonCredentialSelectors)
+	case xpv1.CredentialsSourceFilesystem:
+		token, err = resource.ExtractFs(ctx, e.fs, pc.CommonCredentialSelectors)
+	case xpv1.CredentialsSourceInjectedIdentity:
+		token = []byte(e.env(injectedEnv))
+	default:
+		return nil, errors.Errorf(errUnsupportedSource, pc.Source)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t := strings.TrimSpace(string(token))
+	if t == "" {
+		return nil, errors.Errorf(errEmptyCredentials, pc.Source)
+	}
+
+	return &providerCredentials{token: t}, nil
+}
+
+func extractCredentialsFromSecret(ctx context.Context, client client.Client, s xpv1.CommonCredentialSelectors) (*providerCredentials, error) {
+	if s.SecretRef == nil {
+		return nil, errors.New(errExtractSecretKey)
+	}
+
+	token, err := resource.ExtractSecret(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, errors.New(fmt.Sprintf(errInvalidSecretData, s.SecretRef.Key))
+	}
+
+	creds := &providerCredentials{
+		token: strings.TrimSpace(string(token)),
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	xperrors "github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+func TestExtract(t *testing.T) {
+	errBoom := errors.New("boom")
+	path := "/var/run/secrets/zpa/clientID"
+
+	env := func(vars map[string]string) resource.EnvLookupFn {
+		return func(name string) string { return vars[name] }
+	}
+	fs := func(files map[string]string) afero.Fs {
+		fs := afero.NewMemMapFs()
+		for p, content := range files {
+			_ = afero.WriteFile(fs, p, []byte(content), 0600)
+		}
+		return fs
+	}
+	secret := func(data map[string][]byte) client.Client {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*corev1.Secret).Data = data
+				return nil
+			}),
+		}
+	}
+
+	type args struct {
+		e           *credentialsExtractor
+		pc          v1alpha1.ProviderCredentials
+		injectedEnv string
+	}
+	type want struct {
+		creds *providerCredentials
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Secret": {
+			args: args{
+				e: &credentialsExtractor{client: secret(map[string][]byte{"clientID": []byte("id\n")})},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{Key: "clientID"},
+					},
+				},
+			},
+			want: want{creds: &providerCredentials{token: "id"}},
+		},
+		"SecretNoRef": {
+			args: args{
+				e:  &credentialsExtractor{client: secret(nil)},
+				pc: v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			},
+			want: want{err: errors.New(errExtractSecretKey)},
+		},
+		"SecretMissingKey": {
+			args: args{
+				e: &credentialsExtractor{client: secret(map[string][]byte{})},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{Key: "clientID"},
+					},
+				},
+			},
+			want: want{err: errors.New(fmt.Sprintf(errInvalidSecretData, "clientID"))},
+		},
+		"SecretGetError": {
+			args: args{
+				e: &credentialsExtractor{client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{Key: "clientID"},
+					},
+				},
+			},
+			want: want{err: xperrors.Wrap(errBoom, "cannot get credentials secret")},
+		},
+		"Environment": {
+			args: args{
+				e: &credentialsExtractor{env: env(map[string]string{"MY_CLIENT_ID": "id"})},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceEnvironment,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						Env: &xpv1.EnvSelector{Name: "MY_CLIENT_ID"},
+					},
+				},
+			},
+			want: want{creds: &providerCredentials{token: "id"}},
+		},
+		"EnvironmentUnset": {
+			args: args{
+				e: &credentialsExtractor{env: env(nil)},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceEnvironment,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						Env: &xpv1.EnvSelector{Name: "MY_CLIENT_ID"},
+					},
+				},
+			},
+			want: want{err: errors.Errorf(errEmptyCredentials, xpv1.CredentialsSourceEnvironment)},
+		},
+		"EnvironmentNoSelector": {
+			args: args{
+				e:  &credentialsExtractor{env: env(nil)},
+				pc: v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			},
+			want: want{err: xperrors.New("cannot extract from environment variable when none specified")},
+		},
+		"Filesystem": {
+			args: args{
+				e: &credentialsExtractor{fs: fs(map[string]string{path: "id\n"})},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceFilesystem,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						Fs: &xpv1.FsSelector{Path: path},
+					},
+				},
+			},
+			want: want{creds: &providerCredentials{token: "id"}},
+		},
+		"FilesystemMissingFile": {
+			args: args{
+				e: &credentialsExtractor{fs: fs(nil)},
+				pc: v1alpha1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceFilesystem,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						Fs: &xpv1.FsSelector{Path: path},
+					},
+				},
+			},
+			want: want{err: &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}},
+		},
+		"FilesystemNoSelector": {
+			args: args{
+				e:  &credentialsExtractor{fs: fs(nil)},
+				pc: v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem},
+			},
+			want: want{err: xperrors.New("cannot extract from filesystem when no path specified")},
+		},
+		"InjectedIdentity": {
+			args: args{
+				e:           &credentialsExtractor{env: env(map[string]string{EnvClientSecret: "secret"})},
+				pc:          v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+				injectedEnv: EnvClientSecret,
+			},
+			want: want{creds: &providerCredentials{token: "secret"}},
+		},
+		"InjectedIdentityUnset": {
+			args: args{
+				e:           &credentialsExtractor{env: env(nil)},
+				pc:          v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+				injectedEnv: EnvClientSecret,
+			},
+			want: want{err: errors.Errorf(errEmptyCredentials, xpv1.CredentialsSourceInjectedIdentity)},
+		},
+		"None": {
+			args: args{
+				e:  &credentialsExtractor{},
+				pc: v1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+			},
+			want: want{err: errors.Errorf(errUnsupportedSource, xpv1.CredentialsSourceNone)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.args.e.Extract(context.Background(), tc.args.pc, tc.args.injectedEnv)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Extract(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, got, cmp.AllowUnexported(providerCredentials{})); diff != "" {
+				t.Errorf("\ne.Extract(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
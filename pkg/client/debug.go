@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+const redacted = "REDACTED"
+
+var (
+	// redactHeaders matches header lines of a dumped request or response that
+	// carry credentials.
+	redactHeaders = regexp.MustCompile(`(?im)^(Authorization|Cookie|Set-Cookie):[^\r\n]*`)
+
+	// redactForm matches the credentials in a dumped sign-in request body.
+	redactForm = regexp.MustCompile(`(client_id|client_secret)=[^&\s]*`)
+
+	// redactJSON matches the bearer token in a dumped sign-in response body
+	// and the certificate and private key in a dumped BACertificate body.
+	redactJSON = regexp.MustCompile(`"(access_token|certBlob)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+	// redactPrivateKey matches PEM encoded private keys anywhere else in a
+	// dumped request or response.
+	redactPrivateKey = regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+// redact removes credentials from a dumped request or response.
+func redact(dump []byte) string {
+	dump = redactHeaders.ReplaceAll(dump, []byte("$1: "+redacted))
+	dump = redactForm.ReplaceAll(dump, []byte("$1="+redacted))
+	dump = redactJSON.ReplaceAll(dump, []byte(`"$1":"`+redacted+`"`))
+	dump = redactPrivateKey.ReplaceAll(dump, []byte(redacted))
+	return string(dump)
+}
+
+// debugTransport logs all requests to and responses from the ZPA API with
+// credentials redacted.
+type debugTransport struct {
+	log  logging.Logger
+	next http.RoundTripper
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+		t.log.Debug("ZPA API request", "method", req.Method, "url", req.URL.String(), "dump", redact(dump))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.log.Debug("ZPA API request failed", "method", req.Method, "url", req.URL.String(), "error", err)
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpResponse(resp, true); err == nil {
+		t.log.Debug("ZPA API response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "dump", redact(dump))
+	}
+	return resp, nil
+}
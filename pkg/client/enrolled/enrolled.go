@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package enrolled manages ZPA components that enroll themselves using a
+// provisioning key, like App Connectors and Service Edges. Their managed
+// resources adopt existing components, which can be observed, updated and
+// deleted but not created. Deleting a managed resource deletes its component
+// from ZPA, unless its deletion policy is Orphan.
+package enrolled
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	errCreateNotSupported = "%ss enroll using a provisioning key and cannot be created, set the external name annotation to the ID of an existing %s to adopt it"
+	errUpdateFailed       = "cannot update %s"
+	errDescribeFailed     = "cannot describe %s"
+	errDeleteFailed       = "cannot delete %s"
+	errNoExternalName     = "%s has no external name"
+
+	// ControlChannelDisconnected is the control channel status of a
+	// component that is not connected to the ZPA cloud.
+	ControlChannelDisconnected = "ZPN_STATUS_DISCONNECTED"
+)
+
+// An Observation of an enrolled component.
+type Observation struct {
+	// ControlChannelStatus of the component, e.g. ZPN_STATUS_AUTHENTICATED.
+	ControlChannelStatus string
+
+	// UpToDate is true if the component matches the managed resource.
+	UpToDate bool
+
+	// LateInitialized is true if any parameter of the managed resource was
+	// late-initialized from the component.
+	LateInitialized bool
+}
+
+// A Component adapts a managed resource whose external resource is an
+// enrolled component to an External. It manages the component with the
+// client of its kind.
+type Component interface {
+	// Observe gets the component with the supplied ID, records it in the
+	// status of the managed resource and late-initializes its parameters.
+	Observe(ctx context.Context, id string) (Observation, error)
+
+	// Update sets the parameters of the managed resource on the component
+	// with the supplied ID.
+	Update(ctx context.Context, id string) error
+
+	// Delete the component with the supplied ID.
+	Delete(ctx context.Context, id string) error
+}
+
+// A ComponentFn adapts the supplied managed resource to a Component. It
+// returns an error if the managed resource is not of the kind of its
+// External.
+type ComponentFn func(mg resource.Managed) (Component, error)
+
+// A Connector connects managed resources whose external resources are
+// enrolled components of a kind.
+type Connector struct {
+	Kube     client.Client
+	Logger   logging.Logger
+	Recorder event.Recorder
+
+	// Kind of the managed resources, e.g. AppConnector.
+	Kind string
+
+	// Title of the kind of component in messages, e.g. App Connector.
+	Title string
+
+	// NewComponentFn returns the ComponentFn of the External. Its Components
+	// manage components with the supplied transport.
+	NewComponentFn func(transport runtime.ClientTransport) ComponentFn
+}
+
+// Connect returns an External for the supplied managed resource.
+func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := zpaclient.GetConfig(ctx, c.Kube, mg, zpaclient.WithLogger(c.Logger), zpaclient.WithRecorder(c.Recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	componentFn := c.NewComponentFn(cfg)
+	if _, err := componentFn(mg); err != nil {
+		return nil, err
+	}
+
+	return &External{Kind: c.Kind, Title: c.Title, Component: componentFn}, nil
+}
+
+// An External manages the enrolled component of a managed resource.
+type External struct {
+	// Kind of the managed resources, e.g. AppConnector.
+	Kind string
+
+	// Title of the kind of component in messages, e.g. App Connector.
+	Title string
+
+	// Component adapts the managed resources to Components.
+	Component ComponentFn
+}
+
+// Observe the component of the supplied managed resource.
+func (e *External) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, err := e.Component(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	o, reqErr := c.Observe(ctx, id)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrapf(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed, e.Kind)
+	}
+
+	// A component that lost its control channel cannot forward traffic.
+	if o.ControlChannelStatus == ControlChannelDisconnected {
+		mg.SetConditions(v1.Unavailable())
+	} else {
+		mg.SetConditions(v1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        o.UpToDate,
+		ResourceLateInitialized: o.LateInitialized,
+	}, nil
+}
+
+// Create returns an error, because components enroll themselves.
+func (e *External) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, err := e.Component(mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, errors.Errorf(errCreateNotSupported, e.Title, e.Title)
+}
+
+// Update the component of the supplied managed resource.
+func (e *External) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, err := e.Component(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := c.Update(ctx, meta.GetExternalName(mg)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateFailed, e.Kind)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete the component of the supplied managed resource. The component is
+// deleted from ZPA, unless the deletion policy of the managed resource is
+// Orphan.
+func (e *External) Delete(ctx context.Context, mg resource.Managed) error {
+	c, err := e.Component(mg)
+	if err != nil {
+		return err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return errors.Errorf(errNoExternalName, e.Kind)
+	}
+
+	if err := c.Delete(ctx, id); err != nil {
+		return errors.Wrapf(resource.Ignore(operation.IsNotFound, err), errDeleteFailed, e.Kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// headerRequestID is the response header the ZPA API uses to identify a
+	// request in its logs.
+	headerRequestID = "X-Request-Id"
+
+	// maxErrorBodyLen is the number of bytes of a sign-in response that is not
+	// a ZPA error kept in a SignInError.
+	maxErrorBodyLen = 256
+)
+
+// A SignInError is returned when the ZPA API does not issue a bearer token
+// for the credentials of a ProviderConfig.
+type SignInError struct {
+	// StatusCode of the sign-in response.
+	StatusCode int
+
+	// ID of the ZPA error, e.g. "authn.invalid.credentials", if any.
+	ID string
+
+	// Reason given by ZPA for rejecting the sign-in, if any.
+	Reason string
+
+	// Body of the sign-in response if it is not a ZPA error.
+	Body string
+
+	// RequestID assigned to the sign-in request by ZPA, if any.
+	RequestID string
+}
+
+// Error returns a human readable description of the failed sign-in.
+func (e *SignInError) Error() string {
+	msg := fmt.Sprintf("cannot sign in to ZPA: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+
+	switch {
+	case e.ID != "" && e.Reason != "":
+		msg += fmt.Sprintf(": %s: %s", e.ID, e.Reason)
+	case e.ID != "" || e.Reason != "":
+		msg += ": " + e.ID + e.Reason
+	case e.Body != "":
+		msg += ": " + e.Body
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return msg
+}
+
+// IsSignInError returns whether the supplied error is, or wraps, a
+// SignInError.
+func IsSignInError(err error) bool {
+	_, ok := errors.Cause(err).(*SignInError)
+	return ok
+}
+
+// newSignInError builds a SignInError from a rejected sign-in response.
+func newSignInError(res *http.Response, body []byte) *SignInError {
+	e := &SignInError{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get(headerRequestID),
+	}
+
+	zerr := struct {
+		ID     string `json:"id"`
+		Reason string `json:"reason"`
+	}{}
+	if err := json.Unmarshal(body, &zerr); err == nil && (zerr.ID != "" || zerr.Reason != "") {
+		e.ID = zerr.ID
+		e.Reason = zerr.Reason
+		return e
+	}
+
+	e.Body = strings.TrimSpace(string(body))
+	if len(e.Body) > maxErrorBodyLen {
+		e.Body = e.Body[:maxErrorBodyLen] + "..."
+	}
+	return e
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestSignInErrors(t *testing.T) {
+	type response struct {
+		status    int
+		requestID string
+		body      string
+	}
+
+	cases := map[string]struct {
+		response response
+		want     *SignInError
+	}{
+		"Unauthorized": {
+			response: response{
+				status:    http.StatusUnauthorized,
+				requestID: "a1b2c3",
+				body:      `{"id":"authn.invalid.credentials","reason":"invalid client credentials"}`,
+			},
+			want: &SignInError{
+				StatusCode: http.StatusUnauthorized,
+				ID:         "authn.invalid.credentials",
+				Reason:     "invalid client credentials",
+				RequestID:  "a1b2c3",
+			},
+		},
+		"Forbidden": {
+			response: response{
+				status: http.StatusForbidden,
+				body:   `{"id":"api.access.denied","reason":"API key is disabled"}`,
+			},
+			want: &SignInError{
+				StatusCode: http.StatusForbidden,
+				ID:         "api.access.denied",
+				Reason:     "API key is disabled",
+			},
+		},
+		"InternalServerError": {
+			response: response{
+				status: http.StatusInternalServerError,
+				body:   "<html><body>Internal Server Error</body></html>\n",
+			},
+			want: &SignInError{
+				StatusCode: http.StatusInternalServerError,
+				Body:       "<html><body>Internal Server Error</body></html>",
+			},
+		},
+		"ServiceUnavailableLongBody": {
+			response: response{
+				status: http.StatusServiceUnavailable,
+				body:   strings.Repeat("x", maxErrorBodyLen+1),
+			},
+			want: &SignInError{
+				StatusCode: http.StatusServiceUnavailable,
+				Body:       strings.Repeat("x", maxErrorBodyLen) + "...",
+			},
+		},
+		"NoAccessToken": {
+			response: response{
+				status:    http.StatusOK,
+				requestID: "d4e5f6",
+				body:      `{"token_type":"Bearer","expires_in":"3600"}`,
+			},
+			want: &SignInError{
+				StatusCode: http.StatusOK,
+				Reason:     errNoAccessToken,
+				RequestID:  "d4e5f6",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.response.requestID != "" {
+					w.Header().Set(headerRequestID, tc.response.requestID)
+				}
+				w.WriteHeader(tc.response.status)
+				_, _ = w.Write([]byte(tc.response.body))
+			}))
+			defer srv.Close()
+
+			_, err := signIn(context.Background(), srv.Client(), strings.TrimPrefix(srv.URL, "https://"), "client", "secret")
+			if !IsSignInError(errors.Wrap(err, "cannot connect")) {
+				t.Fatalf("signIn(...): want SignInError, got %v", err)
+			}
+			if diff := cmp.Diff(tc.want, err); diff != "" {
+				t.Errorf("signIn(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSignInErrorError(t *testing.T) {
+	cases := map[string]struct {
+		err  *SignInError
+		want string
+	}{
+		"IDAndReason": {
+			err:  &SignInError{StatusCode: http.StatusUnauthorized, ID: "authn.invalid.credentials", Reason: "invalid client credentials", RequestID: "a1b2c3"},
+			want: "cannot sign in to ZPA: 401 Unauthorized: authn.invalid.credentials: invalid client credentials (request ID a1b2c3)",
+		},
+		"ReasonOnly": {
+			err:  &SignInError{StatusCode: http.StatusOK, Reason: errNoAccessToken},
+			want: "cannot sign in to ZPA: 200 OK: " + errNoAccessToken,
+		},
+		"Body": {
+			err:  &SignInError{StatusCode: http.StatusBadGateway, Body: "upstream unavailable"},
+			want: "cannot sign in to ZPA: 502 Bad Gateway: upstream unavailable",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.err.Error()); diff != "" {
+				t.Errorf("e.Error(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
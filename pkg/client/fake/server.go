@@ -0,0 +1,513 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-process fake of the ZPA API for tests.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+const (
+	// ClientID accepted by the fake ZPA API.
+	ClientID = "fake-client-id"
+
+	// ClientSecret accepted by the fake ZPA API.
+	ClientSecret = "fake-client-secret"
+
+	// ProviderConfigName of the ProviderConfig served by Server.Kube.
+	ProviderConfigName = "fake"
+
+	// DefaultConfigSpace of objects created without one.
+	DefaultConfigSpace = "DEFAULT"
+
+	// CreationTime of all objects.
+	CreationTime = "1633046400"
+
+	// ModifiedTime of all updated objects.
+	ModifiedTime = "1633050000"
+
+	// ProvisioningKeyPrefix is followed by the ID of a provisioning key to
+	// form the key that is generated for it.
+	ProvisioningKeyPrefix = "fake-provisioning-key-"
+
+	pathSignIn      = "/signin"
+	pathCustomers   = "/mgmtconfig/v1/admin/customers/"
+	pathCustomersV2 = "/mgmtconfig/v2/admin/customers/"
+	pathUserConfig  = "/userconfig/v1/customers/"
+)
+
+// Collections of the ZPA API that the fake serves. Objects of all of them can
+// be created, read, updated and deleted. Collections that are read-only in the
+// real ZPA API are seeded using Server.Seed. A segment * matches any segment
+// of the path, e.g. the ID of a policy set.
+var Collections = []string{
+	"application",
+	"segmentGroup",
+	"serverGroup",
+	"server",
+	"appConnectorGroup",
+	"connector",
+	"associationType/CONNECTOR_GRP/provisioningKey",
+	"associationType/SERVICE_EDGE_GRP/provisioningKey",
+	"serviceEdgeGroup",
+	"serviceEdge",
+	"policySet/*/rule",
+	"idp",
+	"samlAttribute",
+	"scimgroup/idpId/*",
+	"posture",
+	"network",
+	"certificate",
+	"inspectionProfile",
+	"inspectionControls/custom",
+	"isolation/profiles",
+	"lssConfig",
+	"machineGroup",
+	"enrollmentCert",
+}
+
+// PolicySets holds the ID of the policy set of each policy type, which exist
+// for all customers.
+var PolicySets = map[string]string{
+	"ACCESS_POLICY":            "72058304855000001",
+	"TIMEOUT_POLICY":           "72058304855000002",
+	"CLIENT_FORWARDING_POLICY": "72058304855000003",
+	"INSPECTION_POLICY":        "72058304855000004",
+	"ISOLATION_POLICY":         "72058304855000005",
+}
+
+// An Object of the ZPA API, as decoded from JSON.
+type Object map[string]interface{}
+
+// An apiError is the body the ZPA API returns with an error.
+type apiError struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// A Server is a fake ZPA API that keeps its objects in memory. Like the real
+// ZPA API it answers requests for objects that do not exist with 400 Bad
+// Request.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	tokens      map[string]bool
+	objects     map[string]Object
+	nextID      int
+	collections []string
+
+	// SignIns counts the successful sign-ins.
+	SignIns int
+
+	// Requests counts the requests to the API, excluding sign-ins, by method
+	// and path.
+	Requests map[string]int
+}
+
+// NewServer starts a fake ZPA API that serves TLS with a self-signed
+// certificate. Close the Server once done.
+func NewServer() *Server {
+	s := &Server{
+		tokens:      map[string]bool{},
+		objects:     map[string]Object{},
+		nextID:      72058304855015424,
+		collections: Collections,
+		Requests:    map[string]int{},
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Host of the fake ZPA API, as used by a ProviderConfig.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// CABundle returns the PEM encoded certificate of the fake ZPA API.
+func (s *Server) CABundle() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw})
+}
+
+// Transport returns a runtime.ClientTransport that is signed in to the fake
+// ZPA API.
+func (s *Server) Transport() runtime.ClientTransport {
+	s.mu.Lock()
+	token := "fake-token-" + strconv.Itoa(len(s.tokens))
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	t := httptransport.NewWithClient(s.Host(), "/", []string{"https"}, s.Client())
+	t.DefaultAuthentication = httptransport.BearerToken(token)
+	return t
+}
+
+// Kube returns a Kubernetes client that serves a ProviderConfig named
+// ProviderConfigName, which connects to the fake ZPA API, and the Secret
+// holding its credentials and CA bundle.
+func (s *Server) Kube() client.Client {
+	ref := func(key string) *xpv1.SecretKeySelector {
+		return &xpv1.SecretKeySelector{Key: key, SecretReference: xpv1.SecretReference{Name: "zpa", Namespace: "crossplane-system"}}
+	}
+	pc := &v1alpha1.ProviderConfig{Spec: v1alpha1.ProviderConfigSpec{
+		Host: s.Host(),
+		ClientID: v1alpha1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: ref("clientID")},
+		},
+		ClientSecret: v1alpha1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: ref("clientSecret")},
+		},
+		TLS: &v1alpha1.TLSConfig{CABundleSecretRef: ref("ca.crt")},
+	}}
+	pc.SetName(ProviderConfigName)
+
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1alpha1.ProviderConfig:
+				if key.Name != ProviderConfigName {
+					return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				pc.DeepCopyInto(o)
+			case *corev1.Secret:
+				o.Data = map[string][]byte{
+					"clientID":     []byte(ClientID),
+					"clientSecret": []byte(ClientSecret),
+					"ca.crt":       s.CABundle(),
+				}
+			default:
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			return nil
+		},
+		MockCreate: test.NewMockCreateFn(nil),
+	}
+}
+
+// Seed stores the supplied object in a collection of the named customer and
+// returns its ID.
+func (s *Server) Seed(customerID, collection string, o Object) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.create(customerID, collection, o)
+}
+
+// Get returns the object with the supplied ID from a collection of the named
+// customer.
+func (s *Server) Get(customerID, collection, id string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[key(customerID, collection, id)]
+	return o, ok
+}
+
+func key(customerID, collection, id string) string {
+	return customerID + "/" + collection + "/" + id
+}
+
+// isCollection returns whether the supplied path, relative to a customer, is
+// one of the collections the fake serves.
+func (s *Server) isCollection(path string) bool {
+	for _, c := range s.collections {
+		if match(c, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns whether the supplied path matches the pattern, in which a
+// segment * matches any segment.
+func match(pattern, path string) bool {
+	ps, xs := strings.Split(pattern, "/"), strings.Split(path, "/")
+	if len(ps) != len(xs) {
+		return false
+	}
+	for i := range ps {
+		if ps[i] != "*" && ps[i] != xs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// create stores a new object. Like the ZPA API it defaults the configuration
+// space of the object and records when it was created. Provisioning keys are
+// generated, rules are added at the end of their policy set and only the
+// certificates of an uploaded certificate blob are kept.
+func (s *Server) create(customerID, collection string, o Object) string {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	o["id"] = id
+	if strings.HasSuffix(collection, "/provisioningKey") {
+		o["provisioningKey"] = ProvisioningKeyPrefix + id
+	}
+	if strings.HasSuffix(collection, "/rule") {
+		o["ruleOrder"] = len(s.objectsOf(customerID, collection)) + 1
+	}
+	if blob, ok := o["certBlob"].(string); ok {
+		o["certificate"] = certificates(blob)
+		delete(o, "certBlob")
+	}
+	if _, ok := o["configSpace"]; !ok {
+		o["configSpace"] = DefaultConfigSpace
+	}
+	o["creationTime"] = CreationTime
+	s.objects[key(customerID, collection, id)] = o
+	return id
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == pathSignIn {
+		s.signIn(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Requests[r.Method+" "+r.URL.Path]++
+
+	if !s.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")] {
+		writeJSON(w, http.StatusUnauthorized, apiError{ID: "authentication.failed", Reason: "invalid bearer token"})
+		return
+	}
+
+	// /mgmtconfig/v1/admin/customers/{customerId}/{collection}[/{id}], where
+	// the collection may span several segments of the path. SCIM groups are
+	// served below /userconfig/v1/customers/ and LSS configurations below
+	// /mgmtconfig/v2/admin/customers/ instead.
+	base := pathCustomers
+	switch {
+	case strings.HasPrefix(r.URL.Path, pathUserConfig):
+		base = pathUserConfig
+	case strings.HasPrefix(r.URL.Path, pathCustomersV2):
+		base = pathCustomersV2
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, base), "/")
+	i := strings.Index(path, "/")
+	if !strings.HasPrefix(r.URL.Path, base) || i < 0 {
+		writeJSON(w, http.StatusNotFound, apiError{ID: "resource.not.found", Reason: "no such API"})
+		return
+	}
+	customerID, collection := path[:i], path[i+1:]
+
+	if strings.HasPrefix(collection, "policySet/policyType/") {
+		s.policySet(w, r, strings.TrimPrefix(collection, "policySet/policyType/"))
+		return
+	}
+
+	if match("policySet/*/rule/*/reorder/*", collection) {
+		s.reorder(w, r, customerID, collection)
+		return
+	}
+
+	if s.isCollection(collection) {
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, r, customerID, collection)
+		case http.MethodPost:
+			o := Object{}
+			if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+				writeJSON(w, http.StatusBadRequest, apiError{ID: "invalid.json", Reason: err.Error()})
+				return
+			}
+			s.create(customerID, collection, o)
+			writeJSON(w, http.StatusCreated, o)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	j := strings.LastIndex(collection, "/")
+	if j < 0 || !s.isCollection(collection[:j]) {
+		writeJSON(w, http.StatusNotFound, apiError{ID: "resource.not.found", Reason: "no such API"})
+		return
+	}
+	collection, id := collection[:j], collection[j+1:]
+
+	k := key(customerID, collection, id)
+	o, ok := s.objects[k]
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, apiError{ID: "resource.not.found", Reason: "Resource not found"})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, o)
+	case http.MethodPut:
+		n := Object{}
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			writeJSON(w, http.StatusBadRequest, apiError{ID: "invalid.json", Reason: err.Error()})
+			return
+		}
+		n["id"] = o["id"]
+		n["creationTime"] = o["creationTime"]
+		n["modifiedTime"] = ModifiedTime
+		if _, ok := n["configSpace"]; !ok {
+			n["configSpace"] = o["configSpace"]
+		}
+		for _, f := range []string{"provisioningKey", "ruleOrder"} {
+			if v, ok := o[f]; ok {
+				n[f] = v
+			}
+		}
+		s.objects[k] = n
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		delete(s.objects, k)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) signIn(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if r.FormValue("client_id") != ClientID || r.FormValue("client_secret") != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, apiError{ID: "authentication.failed", Reason: "invalid client credentials"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.SignIns++
+	token := "fake-token-" + strconv.Itoa(len(s.tokens))
+	s.tokens[token] = true
+	writeJSON(w, http.StatusOK, map[string]string{
+		"token_type":   "Bearer",
+		"access_token": token,
+		"expires_in":   "3600",
+	})
+}
+
+// policySet returns the policy set of a policy type. Its rules are not
+// included.
+func (s *Server) policySet(w http.ResponseWriter, r *http.Request, policyType string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	id, ok := PolicySets[policyType]
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, apiError{ID: "resource.not.found", Reason: "Resource not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, Object{"id": id, "name": policyType, "enabled": true})
+}
+
+// reorder moves a rule of a policy set to the rule order at the end of the
+// path. Unlike the ZPA API it does not move the other rules.
+func (s *Server) reorder(w http.ResponseWriter, r *http.Request, customerID, path string) {
+	if r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	seg := strings.Split(path, "/")
+	order, err := strconv.Atoi(seg[5])
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError{ID: "invalid.rule.order", Reason: err.Error()})
+		return
+	}
+	o, ok := s.objects[key(customerID, strings.Join(seg[:3], "/"), seg[3])]
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, apiError{ID: "resource.not.found", Reason: "Resource not found"})
+		return
+	}
+	o["ruleOrder"] = order
+	o["modifiedTime"] = ModifiedTime
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// objectsOf returns the keys of all objects of a collection in order.
+func (s *Server) objectsOf(customerID, collection string) []string {
+	prefix := key(customerID, collection, "")
+	keys := []string{}
+	for k := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// list returns all objects of a collection on a single page. Like the ZPA API
+// it filters them by name if a search is given.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, customerID, collection string) {
+	search := r.URL.Query().Get("search")
+
+	list := []Object{}
+	for _, k := range s.objectsOf(customerID, collection) {
+		o := s.objects[k]
+		if name, _ := o["name"].(string); search != "" && !strings.Contains(name, search) {
+			continue
+		}
+		list = append(list, o)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"totalPages": 1, "list": list})
+}
+
+// certificates returns the PEM encoded certificates of the supplied PEM
+// encoded data, omitting private keys.
+func certificates(data string) string {
+	out := []byte{}
+	for rest := []byte(data); ; {
+		var b *pem.Block
+		b, rest = pem.Decode(rest)
+		if b == nil {
+			return string(out)
+		}
+		if b.Type == "CERTIFICATE" {
+			out = append(out, pem.EncodeToMemory(b)...)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
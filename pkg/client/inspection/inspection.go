@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inspection is a client of the inspection profile and inspection
+// control APIs of ZPA, which make up AppProtection and which zpa-go-client
+// does not cover.
+package inspection
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathProfileCollection       = "/mgmtconfig/v1/admin/customers/{customerId}/inspectionProfile"
+	pathProfileObject           = pathProfileCollection + "/{inspectionProfileId}"
+	pathCustomControlCollection = "/mgmtconfig/v1/admin/customers/{customerId}/inspectionControls/custom"
+	pathCustomControlObject     = pathCustomControlCollection + "/{customControlId}"
+)
+
+// ControlTypeCustom is the control type of custom controls.
+const ControlTypeCustom = "CUSTOM"
+
+// An InspectionProfile of the ZPA API.
+type InspectionProfile struct {
+	ID                        string        `json:"id,omitempty"`
+	Name                      string        `json:"name"`
+	Description               string        `json:"description,omitempty"`
+	ParanoiaLevel             string        `json:"paranoiaLevel"`
+	PredefinedControlsVersion string        `json:"predefinedControlsVersion,omitempty"`
+	PredefinedControls        []Control     `json:"predefinedControls"`
+	CustomControls            []Control     `json:"customControls"`
+	GlobalControlActions      []string      `json:"globalControlActions"`
+	ControlInfoResource       []ControlInfo `json:"controlInfoResource,omitempty"`
+	CreationTime              string        `json:"creationTime,omitempty"`
+	ModifiedBy                string        `json:"modifiedBy,omitempty"`
+	ModifiedTime              string        `json:"modifiedTime,omitempty"`
+}
+
+// A Control of an InspectionProfile and the action it takes. Only its ID,
+// action and action value are sent to the ZPA API.
+type Control struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Action      string `json:"action,omitempty"`
+	ActionValue string `json:"actionValue,omitempty"`
+}
+
+// ControlInfo counts the controls of an InspectionProfile by control type.
+type ControlInfo struct {
+	ControlType string `json:"controlType,omitempty"`
+	Count       string `json:"count,omitempty"`
+}
+
+// A CustomControl of the ZPA API.
+type CustomControl struct {
+	ID                               string              `json:"id,omitempty"`
+	Name                             string              `json:"name"`
+	Description                      string              `json:"description,omitempty"`
+	ControlType                      string              `json:"controlType,omitempty"`
+	ControlNumber                    string              `json:"controlNumber,omitempty"`
+	Type                             string              `json:"type"`
+	Action                           string              `json:"action"`
+	ActionValue                      string              `json:"actionValue,omitempty"`
+	Severity                         string              `json:"severity"`
+	ParanoiaLevel                    string              `json:"paranoiaLevel,omitempty"`
+	Rules                            []Rule              `json:"rules"`
+	AssociatedInspectionProfileNames []AssociatedProfile `json:"associatedInspectionProfileNames,omitempty"`
+	CreationTime                     string              `json:"creationTime,omitempty"`
+	ModifiedBy                       string              `json:"modifiedBy,omitempty"`
+	ModifiedTime                     string              `json:"modifiedTime,omitempty"`
+}
+
+// A Rule of a CustomControl, which matches if all of its conditions match
+// the part of an HTTP message of its type.
+type Rule struct {
+	Type       string      `json:"type"`
+	Names      []string    `json:"names,omitempty"`
+	Conditions []Condition `json:"conditions"`
+}
+
+// A Condition of a Rule.
+type Condition struct {
+	LHS string `json:"lhs"`
+	OP  string `json:"op"`
+	RHS string `json:"rhs"`
+}
+
+// An AssociatedProfile is an InspectionProfile that a CustomControl is a
+// control of.
+type AssociatedProfile struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ClientService is the interface of the inspection profile and inspection
+// control APIs.
+type ClientService interface {
+	GetInspectionProfile(params *GetInspectionProfileParams) (*InspectionProfile, error)
+	AddInspectionProfile(params *AddInspectionProfileParams) (*InspectionProfile, error)
+	UpdateInspectionProfile(params *UpdateInspectionProfileParams) error
+	DeleteInspectionProfile(params *DeleteInspectionProfileParams) error
+	GetCustomControl(params *GetCustomControlParams) (*CustomControl, error)
+	AddCustomControl(params *AddCustomControlParams) (*CustomControl, error)
+	UpdateCustomControl(params *UpdateCustomControlParams) error
+	DeleteCustomControl(params *DeleteCustomControlParams) error
+}
+
+// GetInspectionProfileParams are the parameters of GetInspectionProfile.
+type GetInspectionProfileParams struct {
+	Context             context.Context
+	CustomerID          string
+	InspectionProfileID string
+}
+
+// AddInspectionProfileParams are the parameters of AddInspectionProfile.
+type AddInspectionProfileParams struct {
+	Context           context.Context
+	CustomerID        string
+	InspectionProfile *InspectionProfile
+}
+
+// UpdateInspectionProfileParams are the parameters of
+// UpdateInspectionProfile.
+type UpdateInspectionProfileParams struct {
+	Context             context.Context
+	CustomerID          string
+	InspectionProfileID string
+	InspectionProfile   *InspectionProfile
+}
+
+// DeleteInspectionProfileParams are the parameters of
+// DeleteInspectionProfile.
+type DeleteInspectionProfileParams struct {
+	Context             context.Context
+	CustomerID          string
+	InspectionProfileID string
+}
+
+// GetCustomControlParams are the parameters of GetCustomControl.
+type GetCustomControlParams struct {
+	Context         context.Context
+	CustomerID      string
+	CustomControlID string
+}
+
+// AddCustomControlParams are the parameters of AddCustomControl.
+type AddCustomControlParams struct {
+	Context       context.Context
+	CustomerID    string
+	CustomControl *CustomControl
+}
+
+// UpdateCustomControlParams are the parameters of UpdateCustomControl.
+type UpdateCustomControlParams struct {
+	Context         context.Context
+	CustomerID      string
+	CustomControlID string
+	CustomControl   *CustomControl
+}
+
+// DeleteCustomControlParams are the parameters of DeleteCustomControl.
+type DeleteCustomControlParams struct {
+	Context         context.Context
+	CustomerID      string
+	CustomControlID string
+}
+
+// New creates a client of the inspection profile and inspection control
+// APIs.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the inspection profile and inspection control APIs.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetInspectionProfile gets an inspection profile.
+func (c *Client) GetInspectionProfile(params *GetInspectionProfileParams) (*InspectionProfile, error) {
+	out := &InspectionProfile{}
+	err := operation.Submit(params.Context, c.transport, "getInspectionProfileUsingGET", http.MethodGet, pathProfileObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "inspectionProfileId": params.InspectionProfileID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddInspectionProfile adds an inspection profile.
+func (c *Client) AddInspectionProfile(params *AddInspectionProfileParams) (*InspectionProfile, error) {
+	out := &InspectionProfile{}
+	err := operation.Submit(params.Context, c.transport, "addInspectionProfileUsingPOST", http.MethodPost, pathProfileCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.InspectionProfile,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateInspectionProfile updates an inspection profile.
+func (c *Client) UpdateInspectionProfile(params *UpdateInspectionProfileParams) error {
+	return operation.Submit(params.Context, c.transport, "updateInspectionProfileUsingPUT", http.MethodPut, pathProfileObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "inspectionProfileId": params.InspectionProfileID},
+		Body: params.InspectionProfile,
+	}, nil)
+}
+
+// DeleteInspectionProfile deletes an inspection profile.
+func (c *Client) DeleteInspectionProfile(params *DeleteInspectionProfileParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteInspectionProfileUsingDELETE", http.MethodDelete, pathProfileObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "inspectionProfileId": params.InspectionProfileID},
+	}, nil)
+}
+
+// GetCustomControl gets a custom inspection control.
+func (c *Client) GetCustomControl(params *GetCustomControlParams) (*CustomControl, error) {
+	out := &CustomControl{}
+	err := operation.Submit(params.Context, c.transport, "getCustomControlUsingGET", http.MethodGet, pathCustomControlObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "customControlId": params.CustomControlID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddCustomControl adds a custom inspection control.
+func (c *Client) AddCustomControl(params *AddCustomControlParams) (*CustomControl, error) {
+	out := &CustomControl{}
+	err := operation.Submit(params.Context, c.transport, "addCustomControlUsingPOST", http.MethodPost, pathCustomControlCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.CustomControl,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateCustomControl updates a custom inspection control.
+func (c *Client) UpdateCustomControl(params *UpdateCustomControlParams) error {
+	return operation.Submit(params.Context, c.transport, "updateCustomControlUsingPUT", http.MethodPut, pathCustomControlObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "customControlId": params.CustomControlID},
+		Body: params.CustomControl,
+	}, nil)
+}
+
+// DeleteCustomControl deletes a custom inspection control.
+func (c *Client) DeleteCustomControl(params *DeleteCustomControlParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteCustomControlUsingDELETE", http.MethodDelete, pathCustomControlObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "customControlId": params.CustomControlID},
+	}, nil)
+}
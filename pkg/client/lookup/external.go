@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookup
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const errDescribeFailed = "cannot describe %s"
+
+// A LookupFn looks up the object of the supplied managed resource by its name.
+// It records the object in the status of the managed resource and returns its
+// ID. It returns an error if the managed resource is not of the kind of its
+// External.
+type LookupFn func(ctx context.Context, client ClientService, mg resource.Managed) (string, error)
+
+// A Connector connects managed resources that look up objects of a kind.
+type Connector struct {
+	Kube        client.Client
+	NewClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) ClientService
+	Logger      logging.Logger
+	Recorder    event.Recorder
+
+	// Kind of the managed resources, e.g. Idp.
+	Kind string
+
+	// CreateNotSupported is the error of Create. It tells where the objects
+	// are configured.
+	CreateNotSupported string
+
+	// Lookup looks up the objects of the managed resources.
+	Lookup LookupFn
+}
+
+// Connect returns an External for the supplied managed resource.
+func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := zpaclient.GetConfig(ctx, c.Kube, mg, zpaclient.WithLogger(c.Logger), zpaclient.WithRecorder(c.Recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	return &External{
+		Client:             c.NewClientFn(cfg, strfmt.Default),
+		Kind:               c.Kind,
+		CreateNotSupported: c.CreateNotSupported,
+		Lookup:             c.Lookup,
+	}, nil
+}
+
+// An External observes the object that a managed resource looks up. The
+// object is configured outside of this provider, so it is neither created,
+// updated nor deleted.
+type External struct {
+	Client ClientService
+
+	// Kind of the managed resources, e.g. Idp.
+	Kind string
+
+	// CreateNotSupported is the error of Create. It tells where the objects
+	// are configured.
+	CreateNotSupported string
+
+	// Lookup looks up the objects of the managed resources.
+	Lookup LookupFn
+}
+
+// Observe looks up the object of the supplied managed resource. Its external
+// name is the ID of the object.
+func (e *External) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	// The object is not deleted with the managed resource.
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	id, err := e.Lookup(ctx, e.Client, mg)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrapf(resource.Ignore(operation.IsNotFound, err), errDescribeFailed, e.Kind)
+	}
+
+	// The ID changes if the object is recreated under the same name.
+	changed := meta.GetExternalName(mg) != id
+	meta.SetExternalName(mg, id)
+
+	mg.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: changed,
+	}, nil
+}
+
+// Create returns an error, because the object is configured outside of this
+// provider.
+func (e *External) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(e.CreateNotSupported)
+}
+
+// Update does nothing, because the object is configured outside of this
+// provider.
+func (e *External) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete does nothing, because the object is not deleted with the managed
+// resource.
+func (e *External) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+)
+
+const (
+	kind                  = "Idp"
+	otherID               = "72058304855015575"
+	errCreateNotSupported = "identity providers are configured in the ZPA admin portal"
+)
+
+var errBoom = errors.New("boom")
+
+type managedModifier func(*fake.Managed)
+
+func withExternalName(n string) managedModifier {
+	return func(mg *fake.Managed) { meta.SetExternalName(mg, n) }
+}
+
+func withConditions(c ...xpv1.Condition) managedModifier {
+	return func(mg *fake.Managed) { mg.SetConditions(c...) }
+}
+
+func withDeletionTimestamp() managedModifier {
+	return func(mg *fake.Managed) { mg.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1633046400, 0)}) }
+}
+
+func managedResource(m ...managedModifier) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetName("example")
+	for _, f := range m {
+		f(mg)
+	}
+	return mg
+}
+
+// lookupFn returns a LookupFn that returns the supplied ID and error.
+func lookupFn(id string, err error) LookupFn {
+	return func(_ context.Context, _ ClientService, _ resource.Managed) (string, error) {
+		return id, err
+	}
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NoProviderConfigRef": {
+			mg:   managedResource(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				mg := managedResource()
+				mg.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return mg
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &Connector{
+				Kube: srv.Kube(),
+				NewClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+					called = true
+					return New(transport, formats)
+				},
+				Kind:               kind,
+				CreateNotSupported: errCreateNotSupported,
+			}
+
+			got, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+			if e, ok := got.(*External); ok && (e.Kind != kind || e.CreateNotSupported != errCreateNotSupported) {
+				t.Errorf("\nc.Connect(...): want External of kind %q, got %+v", kind, e)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		lookup LookupFn
+		mg     resource.Managed
+		want   want
+	}{
+		// The object is not deleted with the managed resource.
+		"Deleted": {
+			lookup: lookupFn("", errBoom),
+			mg:     managedResource(withExternalName(id), withDeletionTimestamp()),
+			want:   want{mg: managedResource(withExternalName(id), withDeletionTimestamp())},
+		},
+		"NotFound": {
+			lookup: lookupFn("", errNotFound),
+			mg:     managedResource(),
+			want:   want{mg: managedResource()},
+		},
+		"LookupFailed": {
+			lookup: lookupFn("", errBoom),
+			mg:     managedResource(),
+			want:   want{mg: managedResource(), err: errors.Wrap(errBoom, "cannot describe Idp")},
+		},
+		"Found": {
+			lookup: lookupFn(id, nil),
+			mg:     managedResource(),
+			want: want{
+				mg:  managedResource(withExternalName(id), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"AlreadyFound": {
+			lookup: lookupFn(id, nil),
+			mg:     managedResource(withExternalName(id)),
+			want: want{
+				mg:  managedResource(withExternalName(id), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		// The ID changes if the object is recreated under the same name.
+		"Recreated": {
+			lookup: lookupFn(otherID, nil),
+			mg:     managedResource(withExternalName(id)),
+			want: want{
+				mg:  managedResource(withExternalName(otherID), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &External{Kind: kind, Lookup: tc.lookup}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want mg, +got mg:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	e := &External{Kind: kind, CreateNotSupported: errCreateNotSupported}
+
+	_, err := e.Create(context.Background(), managedResource())
+	if diff := cmp.Diff(errors.New(errCreateNotSupported), err, test.EquateErrors()); diff != "" {
+		t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	e := &External{Kind: kind, Lookup: lookupFn("", errBoom)}
+
+	got, err := e.Update(context.Background(), managedResource(withExternalName(id)))
+	if err != nil {
+		t.Errorf("\ne.Update(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalUpdate{}, got); diff != "" {
+		t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	// The External has no client, so Delete must not call the ZPA API.
+	e := &External{Kind: kind, Lookup: lookupFn("", errBoom)}
+
+	if err := e.Delete(context.Background(), managedResource(withExternalName(id))); err != nil {
+		t.Errorf("\ne.Delete(...): %v", err)
+	}
+}
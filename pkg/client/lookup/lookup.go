@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lookup is a client of the ZPA APIs of objects that are configured
+// outside of this provider, like identity providers, and are looked up by
+// name. The ZPA API returns them in pages, which the clients of zpa-go-client
+// do not support.
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathIdps              = "/mgmtconfig/v1/admin/customers/{customerId}/idp"
+	pathSAMLAttributes    = "/mgmtconfig/v1/admin/customers/{customerId}/samlAttribute"
+	pathSCIMGroups        = "/userconfig/v1/customers/{customerId}/scimgroup/idpId/{idpId}"
+	pathPostureProfiles   = "/mgmtconfig/v1/admin/customers/{customerId}/posture"
+	pathTrustedNetworks   = "/mgmtconfig/v1/admin/customers/{customerId}/network"
+	pathIsolationProfiles = "/mgmtconfig/v1/admin/customers/{customerId}/isolation/profiles"
+	pathMachineGroups     = "/mgmtconfig/v1/admin/customers/{customerId}/machineGroup"
+	pathEnrollmentCerts   = "/mgmtconfig/v2/admin/customers/{customerId}/enrollmentCert"
+
+	// pageSize is the largest page size the ZPA API supports.
+	pageSize = "500"
+)
+
+// A SCIMGroup of an identity provider. The ZPA API encodes its IDs as
+// numbers.
+type SCIMGroup struct {
+	ID           json.Number `json:"id"`
+	Name         string      `json:"name"`
+	IdpID        json.Number `json:"idpId"`
+	IdpGroupID   string      `json:"idpGroupId,omitempty"`
+	CreationTime json.Number `json:"creationTime,omitempty"`
+	ModifiedTime json.Number `json:"modifiedTime,omitempty"`
+}
+
+// An IsolationProfile of Cloud Browser Isolation, which is configured in the
+// isolation admin portal.
+type IsolationProfile struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	Enabled            bool   `json:"enabled,omitempty"`
+	IsolationProfileID string `json:"isolationProfileId,omitempty"`
+	IsolationTenantID  string `json:"isolationTenantId,omitempty"`
+	IsolationURL       string `json:"isolationUrl,omitempty"`
+	CreationTime       string `json:"creationTime,omitempty"`
+	ModifiedBy         string `json:"modifiedBy,omitempty"`
+	ModifiedTime       string `json:"modifiedTime,omitempty"`
+}
+
+// An EnrollmentCert is a certificate of the ZPA tenant that App Connectors,
+// Service Edges and machine tunnels enroll with, e.g. Connector.
+type EnrollmentCert struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description,omitempty"`
+	AllowSigning        bool   `json:"allowSigning,omitempty"`
+	ClientCertType      string `json:"clientCertType,omitempty"`
+	IssuedBy            string `json:"issuedBy,omitempty"`
+	IssuedTo            string `json:"issuedTo,omitempty"`
+	ParentCertID        string `json:"parentCertId,omitempty"`
+	ParentCertName      string `json:"parentCertName,omitempty"`
+	SerialNo            string `json:"serialNo,omitempty"`
+	ValidFromInEpochSec string `json:"validFromInEpochSec,omitempty"`
+	ValidToInEpochSec   string `json:"validToInEpochSec,omitempty"`
+	CreationTime        string `json:"creationTime,omitempty"`
+	ModifiedBy          string `json:"modifiedBy,omitempty"`
+	ModifiedTime        string `json:"modifiedTime,omitempty"`
+}
+
+// ClientService is the interface of the lookups.
+type ClientService interface {
+	GetIdpByName(params *GetIdpByNameParams) (*models.Idp, error)
+	GetSAMLAttributeByName(params *GetSAMLAttributeByNameParams) (*models.SamlAttribute, error)
+	GetSCIMGroupByName(params *GetSCIMGroupByNameParams) (*SCIMGroup, error)
+	GetPostureProfileByName(params *GetPostureProfileByNameParams) (*models.PostureProfile, error)
+	GetTrustedNetworkByName(params *GetTrustedNetworkByNameParams) (*models.TrustedNetwork, error)
+	GetIsolationProfileByName(params *GetIsolationProfileByNameParams) (*IsolationProfile, error)
+	GetMachineGroupByName(params *GetMachineGroupByNameParams) (*models.MachineGroup, error)
+	GetEnrollmentCertByName(params *GetEnrollmentCertByNameParams) (*EnrollmentCert, error)
+}
+
+// GetIdpByNameParams are the parameters of GetIdpByName.
+type GetIdpByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetSAMLAttributeByNameParams are the parameters of GetSAMLAttributeByName.
+type GetSAMLAttributeByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetSCIMGroupByNameParams are the parameters of GetSCIMGroupByName.
+type GetSCIMGroupByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	IdpID      string
+	Name       string
+}
+
+// GetPostureProfileByNameParams are the parameters of GetPostureProfileByName.
+type GetPostureProfileByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetTrustedNetworkByNameParams are the parameters of GetTrustedNetworkByName.
+type GetTrustedNetworkByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetIsolationProfileByNameParams are the parameters of
+// GetIsolationProfileByName.
+type GetIsolationProfileByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetMachineGroupByNameParams are the parameters of GetMachineGroupByName.
+type GetMachineGroupByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// GetEnrollmentCertByNameParams are the parameters of GetEnrollmentCertByName.
+type GetEnrollmentCertByNameParams struct {
+	Context    context.Context
+	CustomerID string
+	Name       string
+}
+
+// New creates a client of the lookups.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the lookups.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetIdpByName gets the identity provider with the supplied name.
+func (c *Client) GetIdpByName(params *GetIdpByNameParams) (*models.Idp, error) {
+	out := &models.Idp{}
+	err := c.getByName(params.Context, "getIdpUsingGET1", pathIdps, map[string]string{"customerId": params.CustomerID}, "identity provider", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSAMLAttributeByName gets the SAML attribute with the supplied name.
+func (c *Client) GetSAMLAttributeByName(params *GetSAMLAttributeByNameParams) (*models.SamlAttribute, error) {
+	out := &models.SamlAttribute{}
+	err := c.getByName(params.Context, "getAllAttributesUsingGET3", pathSAMLAttributes, map[string]string{"customerId": params.CustomerID}, "SAML attribute", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSCIMGroupByName gets the SCIM group of an identity provider with the
+// supplied name.
+func (c *Client) GetSCIMGroupByName(params *GetSCIMGroupByNameParams) (*SCIMGroup, error) {
+	out := &SCIMGroup{}
+	err := c.getByName(params.Context, "getScimGroupsByIdpIdUsingGET", pathSCIMGroups, map[string]string{"customerId": params.CustomerID, "idpId": params.IdpID}, "SCIM group", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetPostureProfileByName gets the posture profile with the supplied name.
+func (c *Client) GetPostureProfileByName(params *GetPostureProfileByNameParams) (*models.PostureProfile, error) {
+	out := &models.PostureProfile{}
+	err := c.getByName(params.Context, "getAllAttributesUsingGET_1", pathPostureProfiles, map[string]string{"customerId": params.CustomerID}, "posture profile", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetTrustedNetworkByName gets the trusted network with the supplied name.
+func (c *Client) GetTrustedNetworkByName(params *GetTrustedNetworkByNameParams) (*models.TrustedNetwork, error) {
+	out := &models.TrustedNetwork{}
+	err := c.getByName(params.Context, "getAllTrustedNetworksUsingGET_1", pathTrustedNetworks, map[string]string{"customerId": params.CustomerID}, "trusted network", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetIsolationProfileByName gets the isolation profile with the supplied name.
+func (c *Client) GetIsolationProfileByName(params *GetIsolationProfileByNameParams) (*IsolationProfile, error) {
+	out := &IsolationProfile{}
+	err := c.getByName(params.Context, "getAllIsolationProfilesUsingGET", pathIsolationProfiles, map[string]string{"customerId": params.CustomerID}, "isolation profile", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMachineGroupByName gets the machine group with the supplied name.
+func (c *Client) GetMachineGroupByName(params *GetMachineGroupByNameParams) (*models.MachineGroup, error) {
+	out := &models.MachineGroup{}
+	err := c.getByName(params.Context, "getAllMachineGroupsUsingGET", pathMachineGroups, map[string]string{"customerId": params.CustomerID}, "machine group", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEnrollmentCertByName gets the enrollment certificate with the supplied
+// name.
+func (c *Client) GetEnrollmentCertByName(params *GetEnrollmentCertByNameParams) (*EnrollmentCert, error) {
+	out := &EnrollmentCert{}
+	err := c.getByName(params.Context, "getEnrollmentCertsUsingGET", pathEnrollmentCerts, map[string]string{"customerId": params.CustomerID}, "enrollment certificate", params.Name, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// A page of objects returned by the ZPA API. It encodes the number of pages
+// as a string.
+type page struct {
+	TotalPages json.Number       `json:"totalPages"`
+	List       []json.RawMessage `json:"list"`
+}
+
+// getByName searches all pages of a collection for the object of the
+// supplied kind with the supplied name and decodes it into out. Searches
+// match names partially, so the name is compared again.
+func (c *Client) getByName(ctx context.Context, id, path string, pathParams map[string]string, kind, name string, out interface{}) error {
+	for i := 1; ; i++ {
+		p := &page{}
+		err := operation.Submit(ctx, c.transport, id, http.MethodGet, path, &operation.Params{
+			Path:  pathParams,
+			Query: map[string]string{"page": strconv.Itoa(i), "pagesize": pageSize, "search": name},
+		}, p)
+		if err != nil {
+			return err
+		}
+		for _, raw := range p.List {
+			o := struct {
+				Name string `json:"name"`
+			}{}
+			if err := json.Unmarshal(raw, &o); err != nil {
+				return err
+			}
+			if o.Name == name {
+				return json.Unmarshal(raw, out)
+			}
+		}
+		if n, _ := p.TotalPages.Int64(); int64(i) >= n {
+			return notFound(id, kind, name)
+		}
+	}
+}
+
+// notFound returns an error for an object that does not exist, which
+// operation.IsNotFound recognizes.
+func notFound(id, kind, name string) error {
+	return &operation.Error{Operation: id, Code: http.StatusNotFound, Reason: fmt.Sprintf("%s %q not found", kind, name)}
+}
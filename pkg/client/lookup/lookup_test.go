@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+	idpID      = "72058304855015500"
+)
+
+var errNotFound = &operation.Error{Code: http.StatusNotFound}
+
+// getFn gets the object with the supplied name and returns its ID.
+type getFn func(ctx context.Context, c ClientService, name string) (string, error)
+
+func TestGetByName(t *testing.T) {
+	cases := map[string]struct {
+		collection string
+		name       string
+		get        getFn
+	}{
+		"Idp": {
+			collection: "idp",
+			name:       "Okta",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetIdpByName(&GetIdpByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"SAMLAttribute": {
+			collection: "samlAttribute",
+			name:       "Email_Okta",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetSAMLAttributeByName(&GetSAMLAttributeByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"SCIMGroup": {
+			collection: "scimgroup/idpId/" + idpID,
+			name:       "Engineering",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetSCIMGroupByName(&GetSCIMGroupByNameParams{Context: ctx, CustomerID: customerID, IdpID: idpID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID.String(), nil
+			},
+		},
+		"PostureProfile": {
+			collection: "posture",
+			name:       "CrowdStrike_ZPA_Pre-ZTA",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetPostureProfileByName(&GetPostureProfileByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"TrustedNetwork": {
+			collection: "network",
+			name:       "Corp-Trusted-Networks",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetTrustedNetworkByName(&GetTrustedNetworkByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"IsolationProfile": {
+			collection: "isolation/profiles",
+			name:       "Cloud-Browser-Isolation",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetIsolationProfileByName(&GetIsolationProfileByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"MachineGroup": {
+			collection: "machineGroup",
+			name:       "Corp-Laptops",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetMachineGroupByName(&GetMachineGroupByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+		"EnrollmentCert": {
+			collection: "enrollmentCert",
+			name:       "Connector",
+			get: func(ctx context.Context, c ClientService, name string) (string, error) {
+				obj, err := c.GetEnrollmentCertByName(&GetEnrollmentCertByNameParams{Context: ctx, CustomerID: customerID, Name: name})
+				if err != nil {
+					return "", err
+				}
+				return obj.ID, nil
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := zpafake.NewServer()
+			defer srv.Close()
+
+			// Searches match names partially.
+			srv.Seed(customerID, tc.collection, zpafake.Object{"name": tc.name + " Test"})
+			want := srv.Seed(customerID, tc.collection, zpafake.Object{"name": tc.name})
+
+			ctx := context.Background()
+			c := New(srv.Transport(), strfmt.Default)
+
+			got, err := tc.get(ctx, c, tc.name)
+			if err != nil {
+				t.Fatalf("Get%sByName(...): %v", name, err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Get%sByName(...): -want ID, +got:\n%s\n", name, diff)
+			}
+
+			if _, err := tc.get(ctx, c, "Unknown"); !operation.IsNotFound(err) {
+				t.Errorf("Get%sByName(...): want not found error, got %v", name, err)
+			}
+		})
+	}
+}
+
+func TestGetByNamePages(t *testing.T) {
+	// pages are the lists of identity providers the ZPA API returns per page.
+	pages := [][]map[string]string{
+		{{"id": "1", "name": "Okta Test"}},
+		{{"id": "2", "name": "Okta Staging"}, {"id": id, "name": "Okta"}},
+		{{"id": "3", "name": "Okta Prod"}},
+	}
+
+	type want struct {
+		id    string
+		pages []string
+		err   bool
+	}
+
+	cases := map[string]struct {
+		name  string
+		pages [][]map[string]string
+		want  want
+	}{
+		"FoundOnSecondPage": {
+			name:  "Okta",
+			pages: pages,
+			want:  want{id: id, pages: []string{"1", "2"}},
+		},
+		"NotFoundOnAnyPage": {
+			name:  "Okta Dev",
+			pages: pages,
+			want:  want{pages: []string{"1", "2", "3"}, err: true},
+		},
+		"NoPages": {
+			name: "Okta",
+			want: want{pages: []string{"1"}, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requested := []string{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if q.Get("pagesize") != pageSize || q.Get("search") != tc.name {
+					t.Errorf("GET %s: want pagesize %s and search %q", r.URL, pageSize, tc.name)
+				}
+				requested = append(requested, q.Get("page"))
+
+				list := []map[string]string{}
+				if n, _ := strconv.Atoi(q.Get("page")); n >= 1 && n <= len(tc.pages) {
+					list = tc.pages[n-1]
+				}
+				// The ZPA API encodes the number of pages as a string.
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"totalPages": strconv.Itoa(len(tc.pages)), "list": list})
+			}))
+			defer srv.Close()
+
+			c := New(httptransport.New(strings.TrimPrefix(srv.URL, "http://"), "/", []string{"http"}), strfmt.Default)
+			obj, err := c.GetIdpByName(&GetIdpByNameParams{Context: context.Background(), CustomerID: customerID, Name: tc.name})
+
+			if diff := cmp.Diff(tc.want.err, operation.IsNotFound(err)); diff != "" {
+				t.Errorf("GetIdpByName(...): -want not found error, +got: %v\n%s\n", err, diff)
+			}
+			if obj != nil && obj.ID != tc.want.id {
+				t.Errorf("GetIdpByName(...): want ID %q, got %q", tc.want.id, obj.ID)
+			}
+			if diff := cmp.Diff(tc.want.pages, requested); diff != "" {
+				t.Errorf("GetIdpByName(...): -want requested pages, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
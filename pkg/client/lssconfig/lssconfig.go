@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lssconfig is a client of the Log Streaming Service configuration
+// API of ZPA, which zpa-go-client does not cover.
+package lssconfig
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathCollection = "/mgmtconfig/v2/admin/customers/{customerId}/lssConfig"
+	pathObject     = pathCollection + "/{lssId}"
+)
+
+// ActionLog is the only action of the policy rule of an LSS configuration.
+const ActionLog = "LOG"
+
+// An LSSConfig of the ZPA API. The rule of its policy selects the logs that
+// are streamed.
+type LSSConfig struct {
+	ID                 string             `json:"id,omitempty"`
+	Config             Config             `json:"config"`
+	ConnectorGroups    []ConnectorGroup   `json:"connectorGroups,omitempty"`
+	PolicyRuleResource *models.PolicyRule `json:"policyRuleResource,omitempty"`
+}
+
+// Config of an LSSConfig.
+type Config struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Enabled       bool     `json:"enabled"`
+	LSSHost       string   `json:"lssHost"`
+	LSSPort       string   `json:"lssPort"`
+	Format        string   `json:"format"`
+	SourceLogType string   `json:"sourceLogType"`
+	UseTLS        bool     `json:"useTls"`
+	Filter        []string `json:"filter,omitempty"`
+	CreationTime  string   `json:"creationTime,omitempty"`
+	ModifiedBy    string   `json:"modifiedBy,omitempty"`
+	ModifiedTime  string   `json:"modifiedTime,omitempty"`
+}
+
+// A ConnectorGroup of an LSSConfig, which the logs are streamed from. Only
+// its ID is sent to the ZPA API.
+type ConnectorGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ClientService is the interface of the LSS configuration API.
+type ClientService interface {
+	GetLSSConfig(params *GetLSSConfigParams) (*LSSConfig, error)
+	AddLSSConfig(params *AddLSSConfigParams) (*LSSConfig, error)
+	UpdateLSSConfig(params *UpdateLSSConfigParams) error
+	DeleteLSSConfig(params *DeleteLSSConfigParams) error
+}
+
+// GetLSSConfigParams are the parameters of GetLSSConfig.
+type GetLSSConfigParams struct {
+	Context    context.Context
+	CustomerID string
+	LSSID      string
+}
+
+// AddLSSConfigParams are the parameters of AddLSSConfig.
+type AddLSSConfigParams struct {
+	Context    context.Context
+	CustomerID string
+	LSSConfig  *LSSConfig
+}
+
+// UpdateLSSConfigParams are the parameters of UpdateLSSConfig.
+type UpdateLSSConfigParams struct {
+	Context    context.Context
+	CustomerID string
+	LSSID      string
+	LSSConfig  *LSSConfig
+}
+
+// DeleteLSSConfigParams are the parameters of DeleteLSSConfig.
+type DeleteLSSConfigParams struct {
+	Context    context.Context
+	CustomerID string
+	LSSID      string
+}
+
+// New creates a client of the LSS configuration API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the LSS configuration API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetLSSConfig gets an LSS configuration.
+func (c *Client) GetLSSConfig(params *GetLSSConfigParams) (*LSSConfig, error) {
+	out := &LSSConfig{}
+	err := operation.Submit(params.Context, c.transport, "getLSSConfigUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "lssId": params.LSSID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddLSSConfig adds an LSS configuration.
+func (c *Client) AddLSSConfig(params *AddLSSConfigParams) (*LSSConfig, error) {
+	out := &LSSConfig{}
+	err := operation.Submit(params.Context, c.transport, "addLSSConfigUsingPOST", http.MethodPost, pathCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.LSSConfig,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateLSSConfig updates an LSS configuration.
+func (c *Client) UpdateLSSConfig(params *UpdateLSSConfigParams) error {
+	return operation.Submit(params.Context, c.transport, "updateLSSConfigUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "lssId": params.LSSID},
+		Body: params.LSSConfig,
+	}, nil)
+}
+
+// DeleteLSSConfig deletes an LSS configuration.
+func (c *Client) DeleteLSSConfig(params *DeleteLSSConfigParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteLSSConfigUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "lssId": params.LSSID},
+	}, nil)
+}
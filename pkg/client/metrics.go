@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	metricsNamespace = "zpa"
+
+	labelKind           = "kind"
+	labelProviderConfig = "provider_config"
+	labelOperation      = "operation"
+	labelCode           = "code"
+	labelResult         = "result"
+
+	operationSignIn = "SignIn"
+	codeError       = "error"
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+	resultHit     = "hit"
+	resultMiss    = "miss"
+)
+
+var (
+	// retries counts the requests to the ZPA API that were retried.
+	retries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "retries_total",
+		Help:      "Total number of retried requests to the ZPA API, by HTTP method and reason.",
+	}, []string{"method", "reason"})
+
+	// requestDuration observes how long ZPA API operations take, including
+	// retries and time spent waiting for the rate limiter.
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of ZPA API operations in seconds, including retries.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{labelKind, labelProviderConfig, labelOperation})
+
+	// responses counts the responses of the ZPA API by status code.
+	responses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "responses_total",
+		Help:      "Total number of ZPA API responses, by status code. Requests that failed without a response have code \"error\".",
+	}, []string{labelKind, labelProviderConfig, labelOperation, labelCode})
+
+	// signIns counts the sign-ins to ZPA.
+	signIns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sign_ins_total",
+		Help:      "Total number of sign-ins to ZPA, by result.",
+	}, []string{labelProviderConfig, labelResult})
+
+	// tokenCacheRequests counts the lookups of the bearer token cache.
+	tokenCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "token_cache_requests_total",
+		Help:      "Total number of bearer token cache lookups, by hit or miss.",
+	}, []string{labelProviderConfig, labelResult})
+)
+
+func init() {
+	metrics.Registry.MustRegister(retries, requestDuration, responses, signIns, tokenCacheRequests)
+}
+
+type requestLabelsKey struct{}
+
+// requestLabels of the metrics recorded for a request to the ZPA API.
+type requestLabels struct {
+	kind           string
+	providerConfig string
+	operation      string
+}
+
+func withRequestLabels(ctx context.Context, l requestLabels) context.Context {
+	return context.WithValue(ctx, requestLabelsKey{}, l)
+}
+
+func requestLabelsFrom(ctx context.Context) requestLabels {
+	l, _ := ctx.Value(requestLabelsKey{}).(requestLabels)
+	return l
+}
+
+// kindOf returns the kind of the supplied managed resource.
+func kindOf(mg resource.Managed) string {
+	if k := mg.GetObjectKind().GroupVersionKind().Kind; k != "" {
+		return k
+	}
+	return reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+}
+
+// instrumentedRuntime records the duration of all operations submitted to the
+// ZPA API, and labels their requests for the metricsTransport.
+type instrumentedRuntime struct {
+	runtime        runtime.ClientTransport
+	kind           string
+	providerConfig string
+}
+
+func (r *instrumentedRuntime) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	op.Context = withRequestLabels(ctx, requestLabels{kind: r.kind, providerConfig: r.providerConfig, operation: op.ID})
+
+	start := time.Now()
+	res, err := r.runtime.Submit(op)
+	requestDuration.WithLabelValues(r.kind, r.providerConfig, op.ID).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+// metricsTransport counts the responses of the ZPA API by status code.
+type metricsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := requestLabelsFrom(req.Context())
+
+	resp, err := t.next.RoundTrip(req)
+	code := codeError
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	responses.WithLabelValues(l.kind, l.providerConfig, l.operation, code).Inc()
+	return resp, err
+}
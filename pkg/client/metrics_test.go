@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// roundTripperFunc is an http.RoundTripper that is a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// runtimeFunc is a runtime.ClientTransport that is a function.
+type runtimeFunc func(*runtime.ClientOperation) (interface{}, error)
+
+func (f runtimeFunc) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	return f(op)
+}
+
+func TestMetricsTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/throttled":
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	cases := map[string]struct {
+		path string
+		next http.RoundTripper
+		code string
+	}{
+		"OK": {
+			path: "/",
+			next: http.DefaultTransport,
+			code: "200",
+		},
+		"NotFound": {
+			path: "/missing",
+			next: http.DefaultTransport,
+			code: "404",
+		},
+		"TooManyRequests": {
+			path: "/throttled",
+			next: http.DefaultTransport,
+			code: "429",
+		},
+		"Error": {
+			path: "/",
+			next: roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, errors.New("boom") }),
+			code: codeError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := requestLabels{kind: "MetricsTransportTest", providerConfig: name, operation: "getSegmentGroupUsingGET1"}
+			c := responses.WithLabelValues(l.kind, l.providerConfig, l.operation, tc.code)
+			before := testutil.ToFloat64(c)
+
+			req, _ := http.NewRequestWithContext(withRequestLabels(context.Background(), l), http.MethodGet, srv.URL+tc.path, nil)
+			resp, err := (&metricsTransport{next: tc.next}).RoundTrip(req)
+			if err == nil {
+				closeBody(resp.Body)
+			}
+
+			if diff := cmp.Diff(float64(1), testutil.ToFloat64(c)-before); diff != "" {
+				t.Errorf("t.RoundTrip(...): -want responses_total{code=%q} increment, +got:\n%s\n", tc.code, diff)
+			}
+		})
+	}
+}
+
+func TestInstrumentedRuntime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	// The runtime sends a request with the context of the operation through
+	// the metricsTransport, like the runtime of go-openapi does.
+	next := runtimeFunc(func(op *runtime.ClientOperation) (interface{}, error) {
+		req, _ := http.NewRequestWithContext(op.Context, op.Method, srv.URL+op.PathPattern, nil)
+		resp, err := (&metricsTransport{next: http.DefaultTransport}).RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		closeBody(resp.Body)
+		return nil, nil
+	})
+	r := &instrumentedRuntime{runtime: next, kind: "SegmentGroup", providerConfig: "instrumented-runtime-test"}
+
+	if _, err := r.Submit(&runtime.ClientOperation{ID: "getSegmentGroupUsingGET1", Method: http.MethodGet, PathPattern: "/"}); err != nil {
+		t.Fatalf("r.Submit(...): %v", err)
+	}
+
+	if diff := cmp.Diff(float64(1), testutil.ToFloat64(responses.WithLabelValues("SegmentGroup", "instrumented-runtime-test", "getSegmentGroupUsingGET1", "200"))); diff != "" {
+		t.Errorf("r.Submit(...): -want responses_total labelled by operation, +got:\n%s\n", diff)
+	}
+
+	m := &dto.Metric{}
+	if err := requestDuration.WithLabelValues("SegmentGroup", "instrumented-runtime-test", "getSegmentGroupUsingGET1").(prometheus.Histogram).Write(m); err != nil {
+		t.Fatalf("Write(...): %v", err)
+	}
+	if diff := cmp.Diff(uint64(1), m.GetHistogram().GetSampleCount()); diff != "" {
+		t.Errorf("r.Submit(...): -want request_duration_seconds observations, +got:\n%s\n", diff)
+	}
+}
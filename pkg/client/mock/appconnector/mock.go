@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/appconnector (interfaces: ClientService)
+
+// Package appconnector is a generated GoMock package.
+package appconnector
+
+import (
+	reflect "reflect"
+
+	appconnector "github.com/crossplane-contrib/provider-zpa/pkg/client/appconnector"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/haarchri/zpa-go-client/pkg/models"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteAppConnector mocks base method.
+func (m *MockClientService) DeleteAppConnector(arg0 *appconnector.DeleteAppConnectorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAppConnector", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAppConnector indicates an expected call of DeleteAppConnector.
+func (mr *MockClientServiceMockRecorder) DeleteAppConnector(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppConnector", reflect.TypeOf((*MockClientService)(nil).DeleteAppConnector), arg0)
+}
+
+// GetAppConnector mocks base method.
+func (m *MockClientService) GetAppConnector(arg0 *appconnector.GetAppConnectorParams) (*models.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppConnector", arg0)
+	ret0, _ := ret[0].(*models.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppConnector indicates an expected call of GetAppConnector.
+func (mr *MockClientServiceMockRecorder) GetAppConnector(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppConnector", reflect.TypeOf((*MockClientService)(nil).GetAppConnector), arg0)
+}
+
+// UpdateAppConnector mocks base method.
+func (m *MockClientService) UpdateAppConnector(arg0 *appconnector.UpdateAppConnectorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAppConnector", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAppConnector indicates an expected call of UpdateAppConnector.
+func (mr *MockClientServiceMockRecorder) UpdateAppConnector(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppConnector", reflect.TypeOf((*MockClientService)(nil).UpdateAppConnector), arg0)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/appconnectorgroup (interfaces: ClientService)
+
+// Package appconnectorgroup is a generated GoMock package.
+package appconnectorgroup
+
+import (
+	reflect "reflect"
+
+	appconnectorgroup "github.com/crossplane-contrib/provider-zpa/pkg/client/appconnectorgroup"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/haarchri/zpa-go-client/pkg/models"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAppConnectorGroup mocks base method.
+func (m *MockClientService) AddAppConnectorGroup(arg0 *appconnectorgroup.AddAppConnectorGroupParams) (*models.AppConnectorGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAppConnectorGroup", arg0)
+	ret0, _ := ret[0].(*models.AppConnectorGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAppConnectorGroup indicates an expected call of AddAppConnectorGroup.
+func (mr *MockClientServiceMockRecorder) AddAppConnectorGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAppConnectorGroup", reflect.TypeOf((*MockClientService)(nil).AddAppConnectorGroup), arg0)
+}
+
+// DeleteAppConnectorGroup mocks base method.
+func (m *MockClientService) DeleteAppConnectorGroup(arg0 *appconnectorgroup.DeleteAppConnectorGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAppConnectorGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAppConnectorGroup indicates an expected call of DeleteAppConnectorGroup.
+func (mr *MockClientServiceMockRecorder) DeleteAppConnectorGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppConnectorGroup", reflect.TypeOf((*MockClientService)(nil).DeleteAppConnectorGroup), arg0)
+}
+
+// GetAppConnectorGroup mocks base method.
+func (m *MockClientService) GetAppConnectorGroup(arg0 *appconnectorgroup.GetAppConnectorGroupParams) (*models.AppConnectorGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppConnectorGroup", arg0)
+	ret0, _ := ret[0].(*models.AppConnectorGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppConnectorGroup indicates an expected call of GetAppConnectorGroup.
+func (mr *MockClientServiceMockRecorder) GetAppConnectorGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppConnectorGroup", reflect.TypeOf((*MockClientService)(nil).GetAppConnectorGroup), arg0)
+}
+
+// UpdateAppConnectorGroup mocks base method.
+func (m *MockClientService) UpdateAppConnectorGroup(arg0 *appconnectorgroup.UpdateAppConnectorGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAppConnectorGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAppConnectorGroup indicates an expected call of UpdateAppConnectorGroup.
+func (mr *MockClientServiceMockRecorder) UpdateAppConnectorGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppConnectorGroup", reflect.TypeOf((*MockClientService)(nil).UpdateAppConnectorGroup), arg0)
+}
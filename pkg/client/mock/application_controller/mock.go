@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/haarchri/zpa-go-client/pkg/client/application_controller (interfaces: ClientService)
+
+// Package application_controller is a generated GoMock package.
+package application_controller
+
+import (
+	reflect "reflect"
+
+	runtime "github.com/go-openapi/runtime"
+	gomock "github.com/golang/mock/gomock"
+	application_controller "github.com/haarchri/zpa-go-client/pkg/client/application_controller"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddApplicationUsingPOST1 mocks base method.
+func (m *MockClientService) AddApplicationUsingPOST1(arg0 *application_controller.AddApplicationUsingPOST1Params, arg1 ...application_controller.ClientOption) (*application_controller.AddApplicationUsingPOST1Created, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddApplicationUsingPOST1", varargs...)
+	ret0, _ := ret[0].(*application_controller.AddApplicationUsingPOST1Created)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddApplicationUsingPOST1 indicates an expected call of AddApplicationUsingPOST1.
+func (mr *MockClientServiceMockRecorder) AddApplicationUsingPOST1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApplicationUsingPOST1", reflect.TypeOf((*MockClientService)(nil).AddApplicationUsingPOST1), varargs...)
+}
+
+// DeleteApplicationUsingDELETE1 mocks base method.
+func (m *MockClientService) DeleteApplicationUsingDELETE1(arg0 *application_controller.DeleteApplicationUsingDELETE1Params, arg1 ...application_controller.ClientOption) (*application_controller.DeleteApplicationUsingDELETE1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteApplicationUsingDELETE1", varargs...)
+	ret0, _ := ret[0].(*application_controller.DeleteApplicationUsingDELETE1NoContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteApplicationUsingDELETE1 indicates an expected call of DeleteApplicationUsingDELETE1.
+func (mr *MockClientServiceMockRecorder) DeleteApplicationUsingDELETE1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationUsingDELETE1", reflect.TypeOf((*MockClientService)(nil).DeleteApplicationUsingDELETE1), varargs...)
+}
+
+// GetAllApplicationsUsingGET3 mocks base method.
+func (m *MockClientService) GetAllApplicationsUsingGET3(arg0 *application_controller.GetAllApplicationsUsingGET3Params, arg1 ...application_controller.ClientOption) (*application_controller.GetAllApplicationsUsingGET3OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllApplicationsUsingGET3", varargs...)
+	ret0, _ := ret[0].(*application_controller.GetAllApplicationsUsingGET3OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllApplicationsUsingGET3 indicates an expected call of GetAllApplicationsUsingGET3.
+func (mr *MockClientServiceMockRecorder) GetAllApplicationsUsingGET3(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllApplicationsUsingGET3", reflect.TypeOf((*MockClientService)(nil).GetAllApplicationsUsingGET3), varargs...)
+}
+
+// GetApplicationUsingGET1 mocks base method.
+func (m *MockClientService) GetApplicationUsingGET1(arg0 *application_controller.GetApplicationUsingGET1Params, arg1 ...application_controller.ClientOption) (*application_controller.GetApplicationUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetApplicationUsingGET1", varargs...)
+	ret0, _ := ret[0].(*application_controller.GetApplicationUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationUsingGET1 indicates an expected call of GetApplicationUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetApplicationUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetApplicationUsingGET1), varargs...)
+}
+
+// SetTransport mocks base method.
+func (m *MockClientService) SetTransport(arg0 runtime.ClientTransport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTransport", arg0)
+}
+
+// SetTransport indicates an expected call of SetTransport.
+func (mr *MockClientServiceMockRecorder) SetTransport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockClientService)(nil).SetTransport), arg0)
+}
+
+// UpdateApplicationV2UsingPUT1 mocks base method.
+func (m *MockClientService) UpdateApplicationV2UsingPUT1(arg0 *application_controller.UpdateApplicationV2UsingPUT1Params, arg1 ...application_controller.ClientOption) (*application_controller.UpdateApplicationV2UsingPUT1Created, *application_controller.UpdateApplicationV2UsingPUT1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateApplicationV2UsingPUT1", varargs...)
+	ret0, _ := ret[0].(*application_controller.UpdateApplicationV2UsingPUT1Created)
+	ret1, _ := ret[1].(*application_controller.UpdateApplicationV2UsingPUT1NoContent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateApplicationV2UsingPUT1 indicates an expected call of UpdateApplicationV2UsingPUT1.
+func (mr *MockClientServiceMockRecorder) UpdateApplicationV2UsingPUT1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationV2UsingPUT1", reflect.TypeOf((*MockClientService)(nil).UpdateApplicationV2UsingPUT1), varargs...)
+}
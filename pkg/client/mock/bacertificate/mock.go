@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/bacertificate (interfaces: ClientService)
+
+// Package bacertificate is a generated GoMock package.
+package bacertificate
+
+import (
+	reflect "reflect"
+
+	bacertificate "github.com/crossplane-contrib/provider-zpa/pkg/client/bacertificate"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddBACertificate mocks base method.
+func (m *MockClientService) AddBACertificate(arg0 *bacertificate.AddBACertificateParams) (*bacertificate.BACertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBACertificate", arg0)
+	ret0, _ := ret[0].(*bacertificate.BACertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBACertificate indicates an expected call of AddBACertificate.
+func (mr *MockClientServiceMockRecorder) AddBACertificate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBACertificate", reflect.TypeOf((*MockClientService)(nil).AddBACertificate), arg0)
+}
+
+// DeleteBACertificate mocks base method.
+func (m *MockClientService) DeleteBACertificate(arg0 *bacertificate.DeleteBACertificateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBACertificate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBACertificate indicates an expected call of DeleteBACertificate.
+func (mr *MockClientServiceMockRecorder) DeleteBACertificate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBACertificate", reflect.TypeOf((*MockClientService)(nil).DeleteBACertificate), arg0)
+}
+
+// GetBACertificate mocks base method.
+func (m *MockClientService) GetBACertificate(arg0 *bacertificate.GetBACertificateParams) (*bacertificate.BACertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBACertificate", arg0)
+	ret0, _ := ret[0].(*bacertificate.BACertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBACertificate indicates an expected call of GetBACertificate.
+func (mr *MockClientServiceMockRecorder) GetBACertificate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBACertificate", reflect.TypeOf((*MockClientService)(nil).GetBACertificate), arg0)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/haarchri/zpa-go-client/pkg/client/connector_group_controller (interfaces: ClientService)
+
+// Package connector_group_controller is a generated GoMock package.
+package connector_group_controller
+
+import (
+	reflect "reflect"
+
+	runtime "github.com/go-openapi/runtime"
+	gomock "github.com/golang/mock/gomock"
+	connector_group_controller "github.com/haarchri/zpa-go-client/pkg/client/connector_group_controller"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAppConnectorGroupUsingGET1 mocks base method.
+func (m *MockClientService) GetAppConnectorGroupUsingGET1(arg0 *connector_group_controller.GetAppConnectorGroupUsingGET1Params, arg1 ...connector_group_controller.ClientOption) (*connector_group_controller.GetAppConnectorGroupUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAppConnectorGroupUsingGET1", varargs...)
+	ret0, _ := ret[0].(*connector_group_controller.GetAppConnectorGroupUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppConnectorGroupUsingGET1 indicates an expected call of GetAppConnectorGroupUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetAppConnectorGroupUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppConnectorGroupUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetAppConnectorGroupUsingGET1), varargs...)
+}
+
+// GetAppConnectorGroupsUsingGET1 mocks base method.
+func (m *MockClientService) GetAppConnectorGroupsUsingGET1(arg0 *connector_group_controller.GetAppConnectorGroupsUsingGET1Params, arg1 ...connector_group_controller.ClientOption) (*connector_group_controller.GetAppConnectorGroupsUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAppConnectorGroupsUsingGET1", varargs...)
+	ret0, _ := ret[0].(*connector_group_controller.GetAppConnectorGroupsUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppConnectorGroupsUsingGET1 indicates an expected call of GetAppConnectorGroupsUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetAppConnectorGroupsUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppConnectorGroupsUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetAppConnectorGroupsUsingGET1), varargs...)
+}
+
+// SetTransport mocks base method.
+func (m *MockClientService) SetTransport(arg0 runtime.ClientTransport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTransport", arg0)
+}
+
+// SetTransport indicates an expected call of SetTransport.
+func (mr *MockClientServiceMockRecorder) SetTransport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockClientService)(nil).SetTransport), arg0)
+}
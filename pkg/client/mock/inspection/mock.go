@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/inspection (interfaces: ClientService)
+
+// Package inspection is a generated GoMock package.
+package inspection
+
+import (
+	reflect "reflect"
+
+	inspection "github.com/crossplane-contrib/provider-zpa/pkg/client/inspection"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddCustomControl mocks base method.
+func (m *MockClientService) AddCustomControl(arg0 *inspection.AddCustomControlParams) (*inspection.CustomControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomControl", arg0)
+	ret0, _ := ret[0].(*inspection.CustomControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCustomControl indicates an expected call of AddCustomControl.
+func (mr *MockClientServiceMockRecorder) AddCustomControl(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomControl", reflect.TypeOf((*MockClientService)(nil).AddCustomControl), arg0)
+}
+
+// AddInspectionProfile mocks base method.
+func (m *MockClientService) AddInspectionProfile(arg0 *inspection.AddInspectionProfileParams) (*inspection.InspectionProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInspectionProfile", arg0)
+	ret0, _ := ret[0].(*inspection.InspectionProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddInspectionProfile indicates an expected call of AddInspectionProfile.
+func (mr *MockClientServiceMockRecorder) AddInspectionProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInspectionProfile", reflect.TypeOf((*MockClientService)(nil).AddInspectionProfile), arg0)
+}
+
+// DeleteCustomControl mocks base method.
+func (m *MockClientService) DeleteCustomControl(arg0 *inspection.DeleteCustomControlParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomControl", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomControl indicates an expected call of DeleteCustomControl.
+func (mr *MockClientServiceMockRecorder) DeleteCustomControl(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomControl", reflect.TypeOf((*MockClientService)(nil).DeleteCustomControl), arg0)
+}
+
+// DeleteInspectionProfile mocks base method.
+func (m *MockClientService) DeleteInspectionProfile(arg0 *inspection.DeleteInspectionProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInspectionProfile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInspectionProfile indicates an expected call of DeleteInspectionProfile.
+func (mr *MockClientServiceMockRecorder) DeleteInspectionProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInspectionProfile", reflect.TypeOf((*MockClientService)(nil).DeleteInspectionProfile), arg0)
+}
+
+// GetCustomControl mocks base method.
+func (m *MockClientService) GetCustomControl(arg0 *inspection.GetCustomControlParams) (*inspection.CustomControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomControl", arg0)
+	ret0, _ := ret[0].(*inspection.CustomControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomControl indicates an expected call of GetCustomControl.
+func (mr *MockClientServiceMockRecorder) GetCustomControl(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomControl", reflect.TypeOf((*MockClientService)(nil).GetCustomControl), arg0)
+}
+
+// GetInspectionProfile mocks base method.
+func (m *MockClientService) GetInspectionProfile(arg0 *inspection.GetInspectionProfileParams) (*inspection.InspectionProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInspectionProfile", arg0)
+	ret0, _ := ret[0].(*inspection.InspectionProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInspectionProfile indicates an expected call of GetInspectionProfile.
+func (mr *MockClientServiceMockRecorder) GetInspectionProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInspectionProfile", reflect.TypeOf((*MockClientService)(nil).GetInspectionProfile), arg0)
+}
+
+// UpdateCustomControl mocks base method.
+func (m *MockClientService) UpdateCustomControl(arg0 *inspection.UpdateCustomControlParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomControl", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomControl indicates an expected call of UpdateCustomControl.
+func (mr *MockClientServiceMockRecorder) UpdateCustomControl(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomControl", reflect.TypeOf((*MockClientService)(nil).UpdateCustomControl), arg0)
+}
+
+// UpdateInspectionProfile mocks base method.
+func (m *MockClientService) UpdateInspectionProfile(arg0 *inspection.UpdateInspectionProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInspectionProfile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInspectionProfile indicates an expected call of UpdateInspectionProfile.
+func (mr *MockClientServiceMockRecorder) UpdateInspectionProfile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInspectionProfile", reflect.TypeOf((*MockClientService)(nil).UpdateInspectionProfile), arg0)
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/lookup (interfaces: ClientService)
+
+// Package lookup is a generated GoMock package.
+package lookup
+
+import (
+	reflect "reflect"
+
+	lookup "github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/haarchri/zpa-go-client/pkg/models"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// GetEnrollmentCertByName mocks base method.
+func (m *MockClientService) GetEnrollmentCertByName(arg0 *lookup.GetEnrollmentCertByNameParams) (*lookup.EnrollmentCert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentCertByName", arg0)
+	ret0, _ := ret[0].(*lookup.EnrollmentCert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollmentCertByName indicates an expected call of GetEnrollmentCertByName.
+func (mr *MockClientServiceMockRecorder) GetEnrollmentCertByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentCertByName", reflect.TypeOf((*MockClientService)(nil).GetEnrollmentCertByName), arg0)
+}
+
+// GetIdpByName mocks base method.
+func (m *MockClientService) GetIdpByName(arg0 *lookup.GetIdpByNameParams) (*models.Idp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdpByName", arg0)
+	ret0, _ := ret[0].(*models.Idp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdpByName indicates an expected call of GetIdpByName.
+func (mr *MockClientServiceMockRecorder) GetIdpByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdpByName", reflect.TypeOf((*MockClientService)(nil).GetIdpByName), arg0)
+}
+
+// GetIsolationProfileByName mocks base method.
+func (m *MockClientService) GetIsolationProfileByName(arg0 *lookup.GetIsolationProfileByNameParams) (*lookup.IsolationProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIsolationProfileByName", arg0)
+	ret0, _ := ret[0].(*lookup.IsolationProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIsolationProfileByName indicates an expected call of GetIsolationProfileByName.
+func (mr *MockClientServiceMockRecorder) GetIsolationProfileByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsolationProfileByName", reflect.TypeOf((*MockClientService)(nil).GetIsolationProfileByName), arg0)
+}
+
+// GetMachineGroupByName mocks base method.
+func (m *MockClientService) GetMachineGroupByName(arg0 *lookup.GetMachineGroupByNameParams) (*models.MachineGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineGroupByName", arg0)
+	ret0, _ := ret[0].(*models.MachineGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineGroupByName indicates an expected call of GetMachineGroupByName.
+func (mr *MockClientServiceMockRecorder) GetMachineGroupByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineGroupByName", reflect.TypeOf((*MockClientService)(nil).GetMachineGroupByName), arg0)
+}
+
+// GetPostureProfileByName mocks base method.
+func (m *MockClientService) GetPostureProfileByName(arg0 *lookup.GetPostureProfileByNameParams) (*models.PostureProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostureProfileByName", arg0)
+	ret0, _ := ret[0].(*models.PostureProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostureProfileByName indicates an expected call of GetPostureProfileByName.
+func (mr *MockClientServiceMockRecorder) GetPostureProfileByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostureProfileByName", reflect.TypeOf((*MockClientService)(nil).GetPostureProfileByName), arg0)
+}
+
+// GetSAMLAttributeByName mocks base method.
+func (m *MockClientService) GetSAMLAttributeByName(arg0 *lookup.GetSAMLAttributeByNameParams) (*models.SamlAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSAMLAttributeByName", arg0)
+	ret0, _ := ret[0].(*models.SamlAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSAMLAttributeByName indicates an expected call of GetSAMLAttributeByName.
+func (mr *MockClientServiceMockRecorder) GetSAMLAttributeByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAMLAttributeByName", reflect.TypeOf((*MockClientService)(nil).GetSAMLAttributeByName), arg0)
+}
+
+// GetSCIMGroupByName mocks base method.
+func (m *MockClientService) GetSCIMGroupByName(arg0 *lookup.GetSCIMGroupByNameParams) (*lookup.SCIMGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMGroupByName", arg0)
+	ret0, _ := ret[0].(*lookup.SCIMGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMGroupByName indicates an expected call of GetSCIMGroupByName.
+func (mr *MockClientServiceMockRecorder) GetSCIMGroupByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMGroupByName", reflect.TypeOf((*MockClientService)(nil).GetSCIMGroupByName), arg0)
+}
+
+// GetTrustedNetworkByName mocks base method.
+func (m *MockClientService) GetTrustedNetworkByName(arg0 *lookup.GetTrustedNetworkByNameParams) (*models.TrustedNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrustedNetworkByName", arg0)
+	ret0, _ := ret[0].(*models.TrustedNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrustedNetworkByName indicates an expected call of GetTrustedNetworkByName.
+func (mr *MockClientServiceMockRecorder) GetTrustedNetworkByName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrustedNetworkByName", reflect.TypeOf((*MockClientService)(nil).GetTrustedNetworkByName), arg0)
+}
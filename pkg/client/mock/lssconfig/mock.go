@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/lssconfig (interfaces: ClientService)
+
+// Package lssconfig is a generated GoMock package.
+package lssconfig
+
+import (
+	reflect "reflect"
+
+	lssconfig "github.com/crossplane-contrib/provider-zpa/pkg/client/lssconfig"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddLSSConfig mocks base method.
+func (m *MockClientService) AddLSSConfig(arg0 *lssconfig.AddLSSConfigParams) (*lssconfig.LSSConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLSSConfig", arg0)
+	ret0, _ := ret[0].(*lssconfig.LSSConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLSSConfig indicates an expected call of AddLSSConfig.
+func (mr *MockClientServiceMockRecorder) AddLSSConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLSSConfig", reflect.TypeOf((*MockClientService)(nil).AddLSSConfig), arg0)
+}
+
+// DeleteLSSConfig mocks base method.
+func (m *MockClientService) DeleteLSSConfig(arg0 *lssconfig.DeleteLSSConfigParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLSSConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLSSConfig indicates an expected call of DeleteLSSConfig.
+func (mr *MockClientServiceMockRecorder) DeleteLSSConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLSSConfig", reflect.TypeOf((*MockClientService)(nil).DeleteLSSConfig), arg0)
+}
+
+// GetLSSConfig mocks base method.
+func (m *MockClientService) GetLSSConfig(arg0 *lssconfig.GetLSSConfigParams) (*lssconfig.LSSConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLSSConfig", arg0)
+	ret0, _ := ret[0].(*lssconfig.LSSConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLSSConfig indicates an expected call of GetLSSConfig.
+func (mr *MockClientServiceMockRecorder) GetLSSConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLSSConfig", reflect.TypeOf((*MockClientService)(nil).GetLSSConfig), arg0)
+}
+
+// UpdateLSSConfig mocks base method.
+func (m *MockClientService) UpdateLSSConfig(arg0 *lssconfig.UpdateLSSConfigParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLSSConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLSSConfig indicates an expected call of UpdateLSSConfig.
+func (mr *MockClientServiceMockRecorder) UpdateLSSConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLSSConfig", reflect.TypeOf((*MockClientService)(nil).UpdateLSSConfig), arg0)
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule (interfaces: ClientService)
+
+// Package policyrule is a generated GoMock package.
+package policyrule
+
+import (
+	reflect "reflect"
+
+	policyrule "github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/haarchri/zpa-go-client/pkg/models"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRule mocks base method.
+func (m *MockClientService) AddRule(arg0 *policyrule.AddRuleParams) (*models.PolicyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRule", arg0)
+	ret0, _ := ret[0].(*models.PolicyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRule indicates an expected call of AddRule.
+func (mr *MockClientServiceMockRecorder) AddRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRule", reflect.TypeOf((*MockClientService)(nil).AddRule), arg0)
+}
+
+// DeleteRule mocks base method.
+func (m *MockClientService) DeleteRule(arg0 *policyrule.DeleteRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockClientServiceMockRecorder) DeleteRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockClientService)(nil).DeleteRule), arg0)
+}
+
+// GetPolicySet mocks base method.
+func (m *MockClientService) GetPolicySet(arg0 *policyrule.GetPolicySetParams) (*models.PolicySet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicySet", arg0)
+	ret0, _ := ret[0].(*models.PolicySet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicySet indicates an expected call of GetPolicySet.
+func (mr *MockClientServiceMockRecorder) GetPolicySet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicySet", reflect.TypeOf((*MockClientService)(nil).GetPolicySet), arg0)
+}
+
+// GetRule mocks base method.
+func (m *MockClientService) GetRule(arg0 *policyrule.GetRuleParams) (*models.PolicyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRule", arg0)
+	ret0, _ := ret[0].(*models.PolicyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRule indicates an expected call of GetRule.
+func (mr *MockClientServiceMockRecorder) GetRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRule", reflect.TypeOf((*MockClientService)(nil).GetRule), arg0)
+}
+
+// ReorderRule mocks base method.
+func (m *MockClientService) ReorderRule(arg0 *policyrule.ReorderRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderRule", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderRule indicates an expected call of ReorderRule.
+func (mr *MockClientServiceMockRecorder) ReorderRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderRule", reflect.TypeOf((*MockClientService)(nil).ReorderRule), arg0)
+}
+
+// UpdateRule mocks base method.
+func (m *MockClientService) UpdateRule(arg0 *policyrule.UpdateRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockClientServiceMockRecorder) UpdateRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockClientService)(nil).UpdateRule), arg0)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/provisioningkey (interfaces: ClientService)
+
+// Package provisioningkey is a generated GoMock package.
+package provisioningkey
+
+import (
+	reflect "reflect"
+
+	provisioningkey "github.com/crossplane-contrib/provider-zpa/pkg/client/provisioningkey"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddProvisioningKey mocks base method.
+func (m *MockClientService) AddProvisioningKey(arg0 *provisioningkey.AddProvisioningKeyParams) (*provisioningkey.ProvisioningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProvisioningKey", arg0)
+	ret0, _ := ret[0].(*provisioningkey.ProvisioningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProvisioningKey indicates an expected call of AddProvisioningKey.
+func (mr *MockClientServiceMockRecorder) AddProvisioningKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProvisioningKey", reflect.TypeOf((*MockClientService)(nil).AddProvisioningKey), arg0)
+}
+
+// DeleteProvisioningKey mocks base method.
+func (m *MockClientService) DeleteProvisioningKey(arg0 *provisioningkey.DeleteProvisioningKeyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProvisioningKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProvisioningKey indicates an expected call of DeleteProvisioningKey.
+func (mr *MockClientServiceMockRecorder) DeleteProvisioningKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvisioningKey", reflect.TypeOf((*MockClientService)(nil).DeleteProvisioningKey), arg0)
+}
+
+// GetProvisioningKey mocks base method.
+func (m *MockClientService) GetProvisioningKey(arg0 *provisioningkey.GetProvisioningKeyParams) (*provisioningkey.ProvisioningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisioningKey", arg0)
+	ret0, _ := ret[0].(*provisioningkey.ProvisioningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisioningKey indicates an expected call of GetProvisioningKey.
+func (mr *MockClientServiceMockRecorder) GetProvisioningKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisioningKey", reflect.TypeOf((*MockClientService)(nil).GetProvisioningKey), arg0)
+}
+
+// UpdateProvisioningKey mocks base method.
+func (m *MockClientService) UpdateProvisioningKey(arg0 *provisioningkey.UpdateProvisioningKeyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProvisioningKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProvisioningKey indicates an expected call of UpdateProvisioningKey.
+func (mr *MockClientServiceMockRecorder) UpdateProvisioningKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvisioningKey", reflect.TypeOf((*MockClientService)(nil).UpdateProvisioningKey), arg0)
+}
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/haarchri/zpa-go-client/pkg/client/segment_group_controller (interfaces: ClientService)
+
+// Package segment_group_controller is a generated GoMock package.
+package segment_group_controller
+
+import (
+	reflect "reflect"
+
+	runtime "github.com/go-openapi/runtime"
+	gomock "github.com/golang/mock/gomock"
+	segment_group_controller "github.com/haarchri/zpa-go-client/pkg/client/segment_group_controller"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddSegmentGroupUsingPOST1 mocks base method.
+func (m *MockClientService) AddSegmentGroupUsingPOST1(arg0 *segment_group_controller.AddSegmentGroupUsingPOST1Params, arg1 ...segment_group_controller.ClientOption) (*segment_group_controller.AddSegmentGroupUsingPOST1Created, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddSegmentGroupUsingPOST1", varargs...)
+	ret0, _ := ret[0].(*segment_group_controller.AddSegmentGroupUsingPOST1Created)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSegmentGroupUsingPOST1 indicates an expected call of AddSegmentGroupUsingPOST1.
+func (mr *MockClientServiceMockRecorder) AddSegmentGroupUsingPOST1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSegmentGroupUsingPOST1", reflect.TypeOf((*MockClientService)(nil).AddSegmentGroupUsingPOST1), varargs...)
+}
+
+// DeleteSegmentGroupUsingDELETE1 mocks base method.
+func (m *MockClientService) DeleteSegmentGroupUsingDELETE1(arg0 *segment_group_controller.DeleteSegmentGroupUsingDELETE1Params, arg1 ...segment_group_controller.ClientOption) (*segment_group_controller.DeleteSegmentGroupUsingDELETE1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSegmentGroupUsingDELETE1", varargs...)
+	ret0, _ := ret[0].(*segment_group_controller.DeleteSegmentGroupUsingDELETE1NoContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSegmentGroupUsingDELETE1 indicates an expected call of DeleteSegmentGroupUsingDELETE1.
+func (mr *MockClientServiceMockRecorder) DeleteSegmentGroupUsingDELETE1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSegmentGroupUsingDELETE1", reflect.TypeOf((*MockClientService)(nil).DeleteSegmentGroupUsingDELETE1), varargs...)
+}
+
+// GetAllSegmentGroupsUsingGET1 mocks base method.
+func (m *MockClientService) GetAllSegmentGroupsUsingGET1(arg0 *segment_group_controller.GetAllSegmentGroupsUsingGET1Params, arg1 ...segment_group_controller.ClientOption) (*segment_group_controller.GetAllSegmentGroupsUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllSegmentGroupsUsingGET1", varargs...)
+	ret0, _ := ret[0].(*segment_group_controller.GetAllSegmentGroupsUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSegmentGroupsUsingGET1 indicates an expected call of GetAllSegmentGroupsUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetAllSegmentGroupsUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSegmentGroupsUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetAllSegmentGroupsUsingGET1), varargs...)
+}
+
+// GetSegmentGroupUsingGET1 mocks base method.
+func (m *MockClientService) GetSegmentGroupUsingGET1(arg0 *segment_group_controller.GetSegmentGroupUsingGET1Params, arg1 ...segment_group_controller.ClientOption) (*segment_group_controller.GetSegmentGroupUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSegmentGroupUsingGET1", varargs...)
+	ret0, _ := ret[0].(*segment_group_controller.GetSegmentGroupUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSegmentGroupUsingGET1 indicates an expected call of GetSegmentGroupUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetSegmentGroupUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegmentGroupUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetSegmentGroupUsingGET1), varargs...)
+}
+
+// SetTransport mocks base method.
+func (m *MockClientService) SetTransport(arg0 runtime.ClientTransport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTransport", arg0)
+}
+
+// SetTransport indicates an expected call of SetTransport.
+func (mr *MockClientServiceMockRecorder) SetTransport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockClientService)(nil).SetTransport), arg0)
+}
+
+// UpdateSegmentGroupUsingPUT1 mocks base method.
+func (m *MockClientService) UpdateSegmentGroupUsingPUT1(arg0 *segment_group_controller.UpdateSegmentGroupUsingPUT1Params, arg1 ...segment_group_controller.ClientOption) (*segment_group_controller.UpdateSegmentGroupUsingPUT1Created, *segment_group_controller.UpdateSegmentGroupUsingPUT1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSegmentGroupUsingPUT1", varargs...)
+	ret0, _ := ret[0].(*segment_group_controller.UpdateSegmentGroupUsingPUT1Created)
+	ret1, _ := ret[1].(*segment_group_controller.UpdateSegmentGroupUsingPUT1NoContent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSegmentGroupUsingPUT1 indicates an expected call of UpdateSegmentGroupUsingPUT1.
+func (mr *MockClientServiceMockRecorder) UpdateSegmentGroupUsingPUT1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSegmentGroupUsingPUT1", reflect.TypeOf((*MockClientService)(nil).UpdateSegmentGroupUsingPUT1), varargs...)
+}
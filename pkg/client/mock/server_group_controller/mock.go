@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/haarchri/zpa-go-client/pkg/client/server_group_controller (interfaces: ClientService)
+
+// Package server_group_controller is a generated GoMock package.
+package server_group_controller
+
+import (
+	reflect "reflect"
+
+	runtime "github.com/go-openapi/runtime"
+	gomock "github.com/golang/mock/gomock"
+	server_group_controller "github.com/haarchri/zpa-go-client/pkg/client/server_group_controller"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddAppServerGroupUsingPOST1 mocks base method.
+func (m *MockClientService) AddAppServerGroupUsingPOST1(arg0 *server_group_controller.AddAppServerGroupUsingPOST1Params, arg1 ...server_group_controller.ClientOption) (*server_group_controller.AddAppServerGroupUsingPOST1Created, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddAppServerGroupUsingPOST1", varargs...)
+	ret0, _ := ret[0].(*server_group_controller.AddAppServerGroupUsingPOST1Created)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAppServerGroupUsingPOST1 indicates an expected call of AddAppServerGroupUsingPOST1.
+func (mr *MockClientServiceMockRecorder) AddAppServerGroupUsingPOST1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAppServerGroupUsingPOST1", reflect.TypeOf((*MockClientService)(nil).AddAppServerGroupUsingPOST1), varargs...)
+}
+
+// DeleteAppServerGroupUsingDELETE1 mocks base method.
+func (m *MockClientService) DeleteAppServerGroupUsingDELETE1(arg0 *server_group_controller.DeleteAppServerGroupUsingDELETE1Params, arg1 ...server_group_controller.ClientOption) (*server_group_controller.DeleteAppServerGroupUsingDELETE1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAppServerGroupUsingDELETE1", varargs...)
+	ret0, _ := ret[0].(*server_group_controller.DeleteAppServerGroupUsingDELETE1NoContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAppServerGroupUsingDELETE1 indicates an expected call of DeleteAppServerGroupUsingDELETE1.
+func (mr *MockClientServiceMockRecorder) DeleteAppServerGroupUsingDELETE1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppServerGroupUsingDELETE1", reflect.TypeOf((*MockClientService)(nil).DeleteAppServerGroupUsingDELETE1), varargs...)
+}
+
+// GetAllServerGroupsUsingGET1 mocks base method.
+func (m *MockClientService) GetAllServerGroupsUsingGET1(arg0 *server_group_controller.GetAllServerGroupsUsingGET1Params, arg1 ...server_group_controller.ClientOption) (*server_group_controller.GetAllServerGroupsUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllServerGroupsUsingGET1", varargs...)
+	ret0, _ := ret[0].(*server_group_controller.GetAllServerGroupsUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllServerGroupsUsingGET1 indicates an expected call of GetAllServerGroupsUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetAllServerGroupsUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServerGroupsUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetAllServerGroupsUsingGET1), varargs...)
+}
+
+// GetServerGroupUsingGET1 mocks base method.
+func (m *MockClientService) GetServerGroupUsingGET1(arg0 *server_group_controller.GetServerGroupUsingGET1Params, arg1 ...server_group_controller.ClientOption) (*server_group_controller.GetServerGroupUsingGET1OK, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServerGroupUsingGET1", varargs...)
+	ret0, _ := ret[0].(*server_group_controller.GetServerGroupUsingGET1OK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerGroupUsingGET1 indicates an expected call of GetServerGroupUsingGET1.
+func (mr *MockClientServiceMockRecorder) GetServerGroupUsingGET1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerGroupUsingGET1", reflect.TypeOf((*MockClientService)(nil).GetServerGroupUsingGET1), varargs...)
+}
+
+// SetTransport mocks base method.
+func (m *MockClientService) SetTransport(arg0 runtime.ClientTransport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTransport", arg0)
+}
+
+// SetTransport indicates an expected call of SetTransport.
+func (mr *MockClientServiceMockRecorder) SetTransport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockClientService)(nil).SetTransport), arg0)
+}
+
+// UpdateAppServerGroupUsingPUT1 mocks base method.
+func (m *MockClientService) UpdateAppServerGroupUsingPUT1(arg0 *server_group_controller.UpdateAppServerGroupUsingPUT1Params, arg1 ...server_group_controller.ClientOption) (*server_group_controller.UpdateAppServerGroupUsingPUT1Created, *server_group_controller.UpdateAppServerGroupUsingPUT1NoContent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAppServerGroupUsingPUT1", varargs...)
+	ret0, _ := ret[0].(*server_group_controller.UpdateAppServerGroupUsingPUT1Created)
+	ret1, _ := ret[1].(*server_group_controller.UpdateAppServerGroupUsingPUT1NoContent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateAppServerGroupUsingPUT1 indicates an expected call of UpdateAppServerGroupUsingPUT1.
+func (mr *MockClientServiceMockRecorder) UpdateAppServerGroupUsingPUT1(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppServerGroupUsingPUT1", reflect.TypeOf((*MockClientService)(nil).UpdateAppServerGroupUsingPUT1), varargs...)
+}
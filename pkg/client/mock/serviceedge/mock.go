@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedge (interfaces: ClientService)
+
+// Package serviceedge is a generated GoMock package.
+package serviceedge
+
+import (
+	reflect "reflect"
+
+	serviceedge "github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedge"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteServiceEdge mocks base method.
+func (m *MockClientService) DeleteServiceEdge(arg0 *serviceedge.DeleteServiceEdgeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceEdge", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceEdge indicates an expected call of DeleteServiceEdge.
+func (mr *MockClientServiceMockRecorder) DeleteServiceEdge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceEdge", reflect.TypeOf((*MockClientService)(nil).DeleteServiceEdge), arg0)
+}
+
+// GetServiceEdge mocks base method.
+func (m *MockClientService) GetServiceEdge(arg0 *serviceedge.GetServiceEdgeParams) (*serviceedge.ServiceEdge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceEdge", arg0)
+	ret0, _ := ret[0].(*serviceedge.ServiceEdge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceEdge indicates an expected call of GetServiceEdge.
+func (mr *MockClientServiceMockRecorder) GetServiceEdge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceEdge", reflect.TypeOf((*MockClientService)(nil).GetServiceEdge), arg0)
+}
+
+// UpdateServiceEdge mocks base method.
+func (m *MockClientService) UpdateServiceEdge(arg0 *serviceedge.UpdateServiceEdgeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceEdge", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceEdge indicates an expected call of UpdateServiceEdge.
+func (mr *MockClientServiceMockRecorder) UpdateServiceEdge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceEdge", reflect.TypeOf((*MockClientService)(nil).UpdateServiceEdge), arg0)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedgegroup (interfaces: ClientService)
+
+// Package serviceedgegroup is a generated GoMock package.
+package serviceedgegroup
+
+import (
+	reflect "reflect"
+
+	serviceedgegroup "github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedgegroup"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientService is a mock of ClientService interface.
+type MockClientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientServiceMockRecorder
+}
+
+// MockClientServiceMockRecorder is the mock recorder for MockClientService.
+type MockClientServiceMockRecorder struct {
+	mock *MockClientService
+}
+
+// NewMockClientService creates a new mock instance.
+func NewMockClientService(ctrl *gomock.Controller) *MockClientService {
+	mock := &MockClientService{ctrl: ctrl}
+	mock.recorder = &MockClientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
+	return m.recorder
+}
+
+// AddServiceEdgeGroup mocks base method.
+func (m *MockClientService) AddServiceEdgeGroup(arg0 *serviceedgegroup.AddServiceEdgeGroupParams) (*serviceedgegroup.ServiceEdgeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceEdgeGroup", arg0)
+	ret0, _ := ret[0].(*serviceedgegroup.ServiceEdgeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddServiceEdgeGroup indicates an expected call of AddServiceEdgeGroup.
+func (mr *MockClientServiceMockRecorder) AddServiceEdgeGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceEdgeGroup", reflect.TypeOf((*MockClientService)(nil).AddServiceEdgeGroup), arg0)
+}
+
+// DeleteServiceEdgeGroup mocks base method.
+func (m *MockClientService) DeleteServiceEdgeGroup(arg0 *serviceedgegroup.DeleteServiceEdgeGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceEdgeGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceEdgeGroup indicates an expected call of DeleteServiceEdgeGroup.
+func (mr *MockClientServiceMockRecorder) DeleteServiceEdgeGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceEdgeGroup", reflect.TypeOf((*MockClientService)(nil).DeleteServiceEdgeGroup), arg0)
+}
+
+// GetServiceEdgeGroup mocks base method.
+func (m *MockClientService) GetServiceEdgeGroup(arg0 *serviceedgegroup.GetServiceEdgeGroupParams) (*serviceedgegroup.ServiceEdgeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceEdgeGroup", arg0)
+	ret0, _ := ret[0].(*serviceedgegroup.ServiceEdgeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceEdgeGroup indicates an expected call of GetServiceEdgeGroup.
+func (mr *MockClientServiceMockRecorder) GetServiceEdgeGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceEdgeGroup", reflect.TypeOf((*MockClientService)(nil).GetServiceEdgeGroup), arg0)
+}
+
+// UpdateServiceEdgeGroup mocks base method.
+func (m *MockClientService) UpdateServiceEdgeGroup(arg0 *serviceedgegroup.UpdateServiceEdgeGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceEdgeGroup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceEdgeGroup indicates an expected call of UpdateServiceEdgeGroup.
+func (mr *MockClientServiceMockRecorder) UpdateServiceEdgeGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceEdgeGroup", reflect.TypeOf((*MockClientService)(nil).UpdateServiceEdgeGroup), arg0)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operation submits operations of the ZPA API that zpa-go-client
+// does not cover, using the same go-openapi runtime as its generated clients.
+package operation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+const idResourceNotFound = "resource.not.found"
+
+// Params of an operation of the ZPA API.
+type Params struct {
+	// Path parameters by name, e.g. customerId.
+	Path map[string]string
+
+	// Query parameters by name.
+	Query map[string]string
+
+	// Body is encoded as JSON, if not nil.
+	Body interface{}
+}
+
+// WriteToRequest writes the parameters to a request of the go-openapi runtime.
+func (p *Params) WriteToRequest(r runtime.ClientRequest, _ strfmt.Registry) error {
+	for k, v := range p.Path {
+		if err := r.SetPathParam(k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range p.Query {
+		if err := r.SetQueryParam(k, v); err != nil {
+			return err
+		}
+	}
+	if p.Body != nil {
+		return r.SetBodyParam(p.Body)
+	}
+	return nil
+}
+
+// An Error is returned for each response of the ZPA API that is not
+// successful.
+type Error struct {
+	// Operation that failed.
+	Operation string `json:"-"`
+
+	// Code is the HTTP status code of the response.
+	Code int `json:"-"`
+
+	// ID and Reason are returned by the ZPA API in the response body.
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("%s: [%d]", e.Operation, e.Code)
+	}
+	return fmt.Sprintf("%s: [%d] %s", e.Operation, e.Code, e.Reason)
+}
+
+// IsNotFound returns whether the supplied error is returned for an object
+// that does not exist. The ZPA API responds with 400 Bad Request rather than
+// 404 Not Found in that case.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Code == http.StatusNotFound || (e.Code == http.StatusBadRequest && e.ID == idResourceNotFound)
+}
+
+// Submit an operation of the ZPA API. The body of a successful response is
+// decoded into result, unless it is nil.
+func Submit(ctx context.Context, t runtime.ClientTransport, id, method, path string, params *Params, result interface{}) error {
+	_, err := t.Submit(&runtime.ClientOperation{
+		ID:                 id,
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"*/*"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &reader{operation: id, result: result},
+		Context:            ctx,
+	})
+	return err
+}
+
+type reader struct {
+	operation string
+	result    interface{}
+}
+
+func (r *reader) ReadResponse(resp runtime.ClientResponse, c runtime.Consumer) (interface{}, error) {
+	if resp.Code() < 200 || resp.Code() > 299 {
+		e := &Error{Operation: r.operation, Code: resp.Code()}
+		// The body is informational only, so a body that cannot be decoded
+		// does not hide the status code.
+		_ = c.Consume(resp.Body(), e)
+		return nil, e
+	}
+	if r.result == nil {
+		return nil, nil
+	}
+	if err := c.Consume(resp.Body(), r.result); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return r.result, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrule
+
+import (
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+const lhsID = "id"
+
+// GenerateConditions returns the condition sets of a policy rule for the
+// supplied conditions.
+func GenerateConditions(in []common.PolicyRuleCondition) []*models.ConditionSet {
+	out := make([]*models.ConditionSet, 0, len(in))
+	for _, c := range in {
+		cs := &models.ConditionSet{
+			Negated:  c.Negated,
+			Operator: c.Operator,
+			Operands: make([]*models.Operand, 0, len(c.Operands)),
+		}
+		for _, o := range c.Operands {
+			cs.Operands = append(cs.Operands, generateOperand(o))
+		}
+		out = append(out, cs)
+	}
+	return out
+}
+
+func generateOperand(in common.PolicyRuleOperand) *models.Operand {
+	o := &models.Operand{
+		ObjectType: in.ObjectType,
+		LHS:        in.LHS,
+		IdpID:      in.IdpID,
+	}
+	if in.RHS != nil {
+		o.RHS = *in.RHS
+	}
+	if o.LHS == "" {
+		switch in.ObjectType {
+		case common.ObjectTypeApp, common.ObjectTypeAppGroup, common.ObjectTypeClientType, common.ObjectTypeMachineGroup:
+			o.LHS = lhsID
+		}
+	}
+	return o
+}
+
+// IsEqualConditions returns whether the observed condition sets of a policy
+// rule are the supplied conditions. The operands of a condition may be
+// observed in any order.
+func IsEqualConditions(in []common.PolicyRuleCondition, observed []*models.ConditionSet) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for i, c := range in {
+		obs := observed[i]
+		if obs == nil || c.Negated != obs.Negated || c.Operator != obs.Operator || len(c.Operands) != len(obs.Operands) {
+			return false
+		}
+		want := map[operandKey]int{}
+		for _, o := range c.Operands {
+			want[keyOf(generateOperand(o))]++
+		}
+		for _, o := range obs.Operands {
+			if o == nil {
+				return false
+			}
+			k := keyOf(o)
+			if want[k] == 0 {
+				return false
+			}
+			want[k]--
+		}
+	}
+	return true
+}
+
+type operandKey struct {
+	objectType, lhs, rhs, idpID string
+}
+
+func keyOf(o *models.Operand) operandKey {
+	return operandKey{objectType: o.ObjectType, lhs: o.LHS, rhs: o.RHS, idpID: o.IdpID}
+}
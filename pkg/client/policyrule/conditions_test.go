@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrule
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+)
+
+const (
+	appID      = "72058304855015500"
+	samlAttrID = "72058304855015430"
+	idpID      = "72058304855015420"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// conditions returns conditions with an operand of each kind of left-hand
+// side.
+func conditions() []common.PolicyRuleCondition {
+	return []common.PolicyRuleCondition{
+		{
+			Operator: "OR",
+			Operands: []common.PolicyRuleOperand{
+				{ObjectType: common.ObjectTypeApp, RHS: strPtr(appID)},
+			},
+		},
+		{
+			Negated:  true,
+			Operator: "OR",
+			Operands: []common.PolicyRuleOperand{
+				{ObjectType: common.ObjectTypeClientType, RHS: strPtr("zpn_client_type_exporter")},
+				{ObjectType: common.ObjectTypeSAML, LHS: samlAttrID, RHS: strPtr("admin@example.com"), IdpID: idpID},
+			},
+		},
+	}
+}
+
+// conditionSets returns the condition sets of the conditions returned by
+// conditions.
+func conditionSets() []*models.ConditionSet {
+	return []*models.ConditionSet{
+		{
+			Operator: "OR",
+			Operands: []*models.Operand{
+				{ObjectType: common.ObjectTypeApp, LHS: lhsID, RHS: appID},
+			},
+		},
+		{
+			Negated:  true,
+			Operator: "OR",
+			Operands: []*models.Operand{
+				{ObjectType: common.ObjectTypeClientType, LHS: lhsID, RHS: "zpn_client_type_exporter"},
+				{ObjectType: common.ObjectTypeSAML, LHS: samlAttrID, RHS: "admin@example.com", IdpID: idpID},
+			},
+		},
+	}
+}
+
+func TestGenerateConditions(t *testing.T) {
+	cases := map[string]struct {
+		in   []common.PolicyRuleCondition
+		want []*models.ConditionSet
+	}{
+		"None": {
+			want: []*models.ConditionSet{},
+		},
+		"Conditions": {
+			in:   conditions(),
+			want: conditionSets(),
+		},
+		// Operands that refer to other ZPA objects by ID default their
+		// left-hand side to id.
+		"DefaultLHS": {
+			in: []common.PolicyRuleCondition{{Operands: []common.PolicyRuleOperand{
+				{ObjectType: common.ObjectTypeApp, RHS: strPtr("1")},
+				{ObjectType: common.ObjectTypeAppGroup, RHS: strPtr("2")},
+				{ObjectType: common.ObjectTypeClientType, RHS: strPtr("3")},
+				{ObjectType: common.ObjectTypeMachineGroup, RHS: strPtr("4")},
+				{ObjectType: common.ObjectTypePosture, LHS: "5", RHS: strPtr("true")},
+			}}},
+			want: []*models.ConditionSet{{Operands: []*models.Operand{
+				{ObjectType: common.ObjectTypeApp, LHS: lhsID, RHS: "1"},
+				{ObjectType: common.ObjectTypeAppGroup, LHS: lhsID, RHS: "2"},
+				{ObjectType: common.ObjectTypeClientType, LHS: lhsID, RHS: "3"},
+				{ObjectType: common.ObjectTypeMachineGroup, LHS: lhsID, RHS: "4"},
+				{ObjectType: common.ObjectTypePosture, LHS: "5", RHS: "true"},
+			}}},
+		},
+		"NoRHS": {
+			in: []common.PolicyRuleCondition{{Operands: []common.PolicyRuleOperand{
+				{ObjectType: common.ObjectTypeSAML, LHS: samlAttrID},
+			}}},
+			want: []*models.ConditionSet{{Operands: []*models.Operand{
+				{ObjectType: common.ObjectTypeSAML, LHS: samlAttrID},
+			}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateConditions(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nGenerateConditions(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestIsEqualConditions(t *testing.T) {
+	observed := func(f func(cs []*models.ConditionSet) []*models.ConditionSet) []*models.ConditionSet {
+		return f(conditionSets())
+	}
+
+	cases := map[string]struct {
+		in       []common.PolicyRuleCondition
+		observed []*models.ConditionSet
+		want     bool
+	}{
+		"None": {
+			want: true,
+		},
+		"Equal": {
+			in:       conditions(),
+			observed: conditionSets(),
+			want:     true,
+		},
+		// The ZPA API returns the operands of a condition in any order and
+		// with their own IDs.
+		"OperandsReordered": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].ID = "72058304855015440"
+				cs[1].Operands[0], cs[1].Operands[1] = cs[1].Operands[1], cs[1].Operands[0]
+				cs[1].Operands[0].ID = "72058304855015441"
+				return cs
+			}),
+			want: true,
+		},
+		"ConditionsReordered": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				return []*models.ConditionSet{cs[1], cs[0]}
+			}),
+		},
+		"ConditionRemoved": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				return cs[:1]
+			}),
+		},
+		"ConditionNil": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[0] = nil
+				return cs
+			}),
+		},
+		"NegatedDrift": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Negated = false
+				return cs
+			}),
+		},
+		"OperatorDrift": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[0].Operator = "AND"
+				return cs
+			}),
+		},
+		"OperandRemoved": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Operands = cs[1].Operands[:1]
+				return cs
+			}),
+		},
+		"OperandNil": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Operands[1] = nil
+				return cs
+			}),
+		},
+		"OperandRHSDrift": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[0].Operands[0].RHS = "72058304855015501"
+				return cs
+			}),
+		},
+		"OperandLHSDrift": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Operands[1].LHS = "72058304855015431"
+				return cs
+			}),
+		},
+		"OperandIdpIDDrift": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Operands[1].IdpID = "72058304855015421"
+				return cs
+			}),
+		},
+		// Operands are compared as a multiset, so a duplicated operand does
+		// not match a different one.
+		"OperandDuplicated": {
+			in: conditions(),
+			observed: observed(func(cs []*models.ConditionSet) []*models.ConditionSet {
+				cs[1].Operands[1] = cs[1].Operands[0]
+				return cs
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEqualConditions(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nIsEqualConditions(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrule
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	errGetPolicySetFailed = "cannot get policy set of policy type %s"
+	errCreateFailed       = "cannot create %s"
+	errUpdateFailed       = "cannot update %s"
+	errReorderFailed      = "cannot reorder %s"
+	errDescribeFailed     = "cannot describe %s"
+	errDeleteFailed       = "cannot delete %s"
+	errNoExternalName     = "%s has no external name"
+)
+
+// A Rule adapts a managed resource whose external resource is a rule of a
+// policy set to an External.
+type Rule interface {
+	// CustomerID returns the ID of the ZPA tenant of the rule.
+	CustomerID() string
+
+	// RuleOrder returns the position of the rule in its policy set, or nil
+	// if the position is not managed.
+	RuleOrder() *int32
+
+	// ObservedRuleOrder returns the position of the rule in its policy set
+	// when it was last observed.
+	ObservedRuleOrder() int32
+
+	// Generate generates the PolicyRule that is sent to the ZPA API.
+	Generate(ctx context.Context) (*models.PolicyRule, error)
+
+	// Observe records the supplied PolicyRule in the status of the managed
+	// resource and late-initializes its parameters. It returns whether the
+	// rule is up to date and whether any parameter was late-initialized.
+	Observe(ctx context.Context, obj *models.PolicyRule) (upToDate, lateInitialized bool, err error)
+}
+
+// A RuleFn adapts the supplied managed resource to a Rule. It returns an error
+// if the managed resource is not of the kind of its External.
+type RuleFn func(mg resource.Managed) (Rule, error)
+
+// A Connector connects managed resources whose external resources are rules
+// of the policy set of a policy type.
+type Connector struct {
+	Kube        client.Client
+	NewClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) ClientService
+	Logger      logging.Logger
+	Recorder    event.Recorder
+
+	// Kind of the managed resources, e.g. AccessPolicyRule.
+	Kind string
+
+	// PolicyType of the policy set of the rules, e.g. ACCESS_POLICY.
+	PolicyType string
+
+	// NewRuleFn returns the RuleFn of the External. Rules that refer to other
+	// ZPA objects by name can look them up with the supplied transport.
+	NewRuleFn func(transport runtime.ClientTransport) RuleFn
+}
+
+// Connect returns an External for the policy set of the customer of the
+// supplied managed resource.
+func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := zpaclient.GetConfig(ctx, c.Kube, mg, zpaclient.WithLogger(c.Logger), zpaclient.WithRecorder(c.Recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	ruleFn := c.NewRuleFn(cfg)
+	r, err := ruleFn(mg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.NewClientFn(cfg, strfmt.Default)
+
+	// The rules of a policy are managed in its policy set, which exists for
+	// each customer.
+	ps, err := client.GetPolicySet(&GetPolicySetParams{
+		Context:    ctx,
+		CustomerID: r.CustomerID(),
+		PolicyType: c.PolicyType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetPolicySetFailed, c.PolicyType)
+	}
+
+	return &External{Client: client, PolicySetID: ps.ID, Kind: c.Kind, Rule: ruleFn}, nil
+}
+
+// An External manages the rule of a managed resource in a policy set.
+type External struct {
+	Client      ClientService
+	PolicySetID string
+
+	// Kind of the managed resources, e.g. AccessPolicyRule.
+	Kind string
+
+	// Rule adapts the managed resources to Rules.
+	Rule RuleFn
+}
+
+// Observe the rule of the supplied managed resource.
+func (e *External) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, err := e.Rule(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &GetRuleParams{
+		Context:     ctx,
+		CustomerID:  r.CustomerID(),
+		PolicySetID: e.PolicySetID,
+		RuleID:      id,
+	}
+	obj, reqErr := e.Client.GetRule(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrapf(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed, e.Kind)
+	}
+
+	upToDate, lateInitialized, err := r.Observe(ctx, obj)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	mg.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+// Create the rule of the supplied managed resource.
+func (e *External) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, err := e.Rule(mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	rule, err := r.Generate(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	req := &AddRuleParams{
+		Context:     ctx,
+		CustomerID:  r.CustomerID(),
+		PolicySetID: e.PolicySetID,
+		Rule:        rule,
+	}
+
+	obj, err := e.Client.AddRule(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errCreateFailed, e.Kind)
+	}
+
+	meta.SetExternalName(mg, obj.ID)
+
+	// A rule is added at the end of the policy set.
+	if o := r.RuleOrder(); o != nil && *o != obj.RuleOrder {
+		if err := e.reorder(ctx, mg, r); err != nil {
+			return managed.ExternalCreation{ExternalNameAssigned: true}, err
+		}
+	}
+
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+// Update the rule of the supplied managed resource.
+func (e *External) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, err := e.Rule(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	rule, err := r.Generate(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	req := &UpdateRuleParams{
+		Context:     ctx,
+		CustomerID:  r.CustomerID(),
+		PolicySetID: e.PolicySetID,
+		RuleID:      meta.GetExternalName(mg),
+		Rule:        rule,
+	}
+
+	if err := e.Client.UpdateRule(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateFailed, e.Kind)
+	}
+
+	if o := r.RuleOrder(); o != nil && *o != r.ObservedRuleOrder() {
+		if err := e.reorder(ctx, mg, r); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete the rule of the supplied managed resource.
+func (e *External) Delete(ctx context.Context, mg resource.Managed) error {
+	r, err := e.Rule(mg)
+	if err != nil {
+		return err
+	}
+
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return errors.Errorf(errNoExternalName, e.Kind)
+	}
+
+	req := &DeleteRuleParams{
+		Context:     ctx,
+		CustomerID:  r.CustomerID(),
+		PolicySetID: e.PolicySetID,
+		RuleID:      id,
+	}
+
+	if err := e.Client.DeleteRule(req); err != nil {
+		return errors.Wrapf(resource.Ignore(operation.IsNotFound, err), errDeleteFailed, e.Kind)
+	}
+
+	return nil
+}
+
+// reorder moves the rule of the supplied managed resource to its rule order.
+func (e *External) reorder(ctx context.Context, mg resource.Managed, r Rule) error {
+	req := &ReorderRuleParams{
+		Context:     ctx,
+		CustomerID:  r.CustomerID(),
+		PolicySetID: e.PolicySetID,
+		RuleID:      meta.GetExternalName(mg),
+		RuleOrder:   zpaclient.Int32Value(r.RuleOrder()),
+	}
+
+	return errors.Wrapf(e.Client.ReorderRule(req), errReorderFailed, e.Kind)
+}
+
+// LateInitialize late-initializes the operator and rule order of the
+// parameters of a rule from the supplied PolicyRule. It returns true if either
+// of them was late-initialized.
+func LateInitialize(operator *string, ruleOrder **int32, obj *models.PolicyRule) bool {
+	li := false
+
+	if *operator == "" && obj.Operator != "" {
+		*operator = obj.Operator
+		li = true
+	}
+
+	if *ruleOrder == nil && obj.RuleOrder != 0 {
+		*ruleOrder = zpaclient.Int32(obj.RuleOrder)
+		li = true
+	}
+
+	return li
+}
@@ -0,0 +1,647 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The tests of the External are in an external test package, because the
+// mock of the ClientService imports this package.
+package policyrule_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockpr "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/policyrule"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+)
+
+const (
+	kind        = "AccessPolicyRule"
+	customerID  = "72058304855015424"
+	policySetID = "72058304855000001"
+	id          = "72058304855015574"
+
+	errNotRule = "managed resource is not an AccessPolicyRule custom resource"
+)
+
+// Errors of the External of AccessPolicyRules.
+const (
+	errGetPolicySetFailed = "cannot get policy set of policy type ACCESS_POLICY"
+	errCreateFailed       = "cannot create AccessPolicyRule"
+	errUpdateFailed       = "cannot update AccessPolicyRule"
+	errReorderFailed      = "cannot reorder AccessPolicyRule"
+	errDescribeFailed     = "cannot describe AccessPolicyRule"
+	errDeleteFailed       = "cannot delete AccessPolicyRule"
+	errNoExternalName     = "AccessPolicyRule has no external name"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+// A rule adapts a fake.Managed to the External.
+type rule struct {
+	ruleOrder         *int32
+	observedRuleOrder int32
+	generateErr       error
+	upToDate          bool
+	lateInitialized   bool
+	observeErr        error
+}
+
+func (r *rule) CustomerID() string {
+	return customerID
+}
+
+func (r *rule) RuleOrder() *int32 {
+	return r.ruleOrder
+}
+
+func (r *rule) ObservedRuleOrder() int32 {
+	return r.observedRuleOrder
+}
+
+func (r *rule) Generate(_ context.Context) (*models.PolicyRule, error) {
+	if r.generateErr != nil {
+		return nil, r.generateErr
+	}
+	return model(), nil
+}
+
+func (r *rule) Observe(_ context.Context, _ *models.PolicyRule) (bool, bool, error) {
+	return r.upToDate, r.lateInitialized, r.observeErr
+}
+
+// ruleFn returns a RuleFn that adapts all managed resources to the supplied
+// rule, or that fails if it is nil.
+func ruleFn(r *rule) policyrule.RuleFn {
+	return func(resource.Managed) (policyrule.Rule, error) {
+		if r == nil {
+			return nil, errors.New(errNotRule)
+		}
+		return r, nil
+	}
+}
+
+func model() *models.PolicyRule {
+	return &models.PolicyRule{Name: zpaclient.String("example"), Action: "ALLOW", Operator: "AND"}
+}
+
+type managedModifier func(*fake.Managed)
+
+func withExternalName(n string) managedModifier {
+	return func(mg *fake.Managed) { meta.SetExternalName(mg, n) }
+}
+
+func withConditions(c ...xpv1.Condition) managedModifier {
+	return func(mg *fake.Managed) { mg.SetConditions(c...) }
+}
+
+func managedResource(m ...managedModifier) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetName("example")
+	for _, f := range m {
+		f(mg)
+	}
+	return mg
+}
+
+func getParams() *policyrule.GetRuleParams {
+	return &policyrule.GetRuleParams{
+		Context:     context.Background(),
+		CustomerID:  customerID,
+		PolicySetID: policySetID,
+		RuleID:      id,
+	}
+}
+
+func reorderParams(order int32) *policyrule.ReorderRuleParams {
+	return &policyrule.ReorderRuleParams{
+		Context:     context.Background(),
+		CustomerID:  customerID,
+		PolicySetID: policySetID,
+		RuleID:      id,
+		RuleOrder:   order,
+	}
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	withProviderConfig := managedModifier(func(mg *fake.Managed) {
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+	})
+
+	type want struct {
+		policySetID string
+		err         error
+	}
+
+	cases := map[string]struct {
+		rule *rule
+		mock func(m *mockpr.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NoProviderConfigRef": {
+			rule: &rule{},
+			mg:   managedResource(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"NotRule": {
+			mg:   managedResource(withProviderConfig),
+			want: want{err: errors.New(errNotRule)},
+		},
+		"GetPolicySetFailed": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().GetPolicySet(&policyrule.GetPolicySetParams{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					PolicyType: policyrule.PolicyTypeAccess,
+				}).Return(nil, errBoom)
+			},
+			mg:   managedResource(withProviderConfig),
+			want: want{err: errors.Wrap(errBoom, errGetPolicySetFailed)},
+		},
+		"Success": {
+			rule: &rule{},
+			mg:   managedResource(withProviderConfig),
+			want: want{policySetID: zpafake.PolicySets[policyrule.PolicyTypeAccess]},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := &policyrule.Connector{
+				Kube: srv.Kube(),
+				NewClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) policyrule.ClientService {
+					if tc.mock != nil {
+						m := mockpr.NewMockClientService(ctrl)
+						tc.mock(m)
+						return m
+					}
+					return policyrule.New(transport, formats)
+				},
+				Kind:       kind,
+				PolicyType: policyrule.PolicyTypeAccess,
+				NewRuleFn:  func(runtime.ClientTransport) policyrule.RuleFn { return ruleFn(tc.rule) },
+			}
+
+			e, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.policySetID, e.(*policyrule.External).PolicySetID); diff != "" {
+				t.Errorf("\nc.Connect(...): -want policy set ID, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	get := func(m *mockpr.MockClientService) {
+		m.EXPECT().GetRule(getParams()).Return(model(), nil)
+	}
+
+	cases := map[string]struct {
+		rule *rule
+		mock func(m *mockpr.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotRule": {
+			mg:   managedResource(withExternalName(id)),
+			want: want{mg: managedResource(withExternalName(id)), err: errors.New(errNotRule)},
+		},
+		"NoExternalName": {
+			rule: &rule{},
+			mg:   managedResource(),
+			want: want{mg: managedResource()},
+		},
+		"NotFound": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().GetRule(getParams()).Return(nil, errNotFound)
+			},
+			mg:   managedResource(withExternalName(id)),
+			want: want{mg: managedResource(withExternalName(id))},
+		},
+		"GetFailed": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().GetRule(getParams()).Return(nil, errBoom)
+			},
+			mg:   managedResource(withExternalName(id)),
+			want: want{mg: managedResource(withExternalName(id)), err: errors.Wrap(errBoom, errDescribeFailed)},
+		},
+		"ObserveFailed": {
+			rule: &rule{observeErr: errBoom},
+			mock: get,
+			mg:   managedResource(withExternalName(id)),
+			want: want{mg: managedResource(withExternalName(id)), err: errBoom},
+		},
+		"UpToDate": {
+			rule: &rule{upToDate: true},
+			mock: get,
+			mg:   managedResource(withExternalName(id)),
+			want: want{
+				mg:  managedResource(withExternalName(id), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			rule: &rule{},
+			mock: get,
+			mg:   managedResource(withExternalName(id)),
+			want: want{
+				mg:  managedResource(withExternalName(id), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"LateInitialized": {
+			rule: &rule{upToDate: true, lateInitialized: true},
+			mock: get,
+			mg:   managedResource(withExternalName(id)),
+			want: want{
+				mg:  managedResource(withExternalName(id), withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpr.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &policyrule.External{Client: m, PolicySetID: policySetID, Kind: kind, Rule: ruleFn(tc.rule)}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want mg, +got mg:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	add := func(order int32) func(m *mockpr.MockClientService) {
+		return func(m *mockpr.MockClientService) {
+			m.EXPECT().AddRule(&policyrule.AddRuleParams{
+				Context:     context.Background(),
+				CustomerID:  customerID,
+				PolicySetID: policySetID,
+				Rule:        model(),
+			}).Return(&models.PolicyRule{ID: id, RuleOrder: order}, nil)
+		}
+	}
+
+	cases := map[string]struct {
+		rule *rule
+		mock func(m *mockpr.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotRule": {
+			mg:   managedResource(),
+			want: want{mg: managedResource(), err: errors.New(errNotRule)},
+		},
+		"GenerateFailed": {
+			rule: &rule{generateErr: errBoom},
+			mg:   managedResource(),
+			want: want{mg: managedResource(), err: errBoom},
+		},
+		"CreateFailed": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().AddRule(gomock.Any()).Return(nil, errBoom)
+			},
+			mg:   managedResource(),
+			want: want{mg: managedResource(), err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+		// A rule without a rule order stays at the end of the policy set.
+		"NoRuleOrder": {
+			rule: &rule{},
+			mock: add(5),
+			mg:   managedResource(),
+			want: want{mg: managedResource(withExternalName(id)), cre: managed.ExternalCreation{ExternalNameAssigned: true}},
+		},
+		"AddedAtRuleOrder": {
+			rule: &rule{ruleOrder: zpaclient.Int32(5)},
+			mock: add(5),
+			mg:   managedResource(),
+			want: want{mg: managedResource(withExternalName(id)), cre: managed.ExternalCreation{ExternalNameAssigned: true}},
+		},
+		"Reorder": {
+			rule: &rule{ruleOrder: zpaclient.Int32(2)},
+			mock: func(m *mockpr.MockClientService) {
+				add(5)(m)
+				m.EXPECT().ReorderRule(reorderParams(2)).Return(nil)
+			},
+			mg:   managedResource(),
+			want: want{mg: managedResource(withExternalName(id)), cre: managed.ExternalCreation{ExternalNameAssigned: true}},
+		},
+		// The external name is kept, so that the rule is not added again.
+		"ReorderFailed": {
+			rule: &rule{ruleOrder: zpaclient.Int32(2)},
+			mock: func(m *mockpr.MockClientService) {
+				add(5)(m)
+				m.EXPECT().ReorderRule(gomock.Any()).Return(errBoom)
+			},
+			mg: managedResource(),
+			want: want{
+				mg:  managedResource(withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+				err: errors.Wrap(errBoom, errReorderFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpr.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &policyrule.External{Client: m, PolicySetID: policySetID, Kind: kind, Rule: ruleFn(tc.rule)}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want mg, +got mg:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	update := func(m *mockpr.MockClientService) {
+		m.EXPECT().UpdateRule(&policyrule.UpdateRuleParams{
+			Context:     context.Background(),
+			CustomerID:  customerID,
+			PolicySetID: policySetID,
+			RuleID:      id,
+			Rule:        model(),
+		}).Return(nil)
+	}
+
+	cases := map[string]struct {
+		rule *rule
+		mock func(m *mockpr.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotRule": {
+			mg:   managedResource(withExternalName(id)),
+			want: errors.New(errNotRule),
+		},
+		"GenerateFailed": {
+			rule: &rule{generateErr: errBoom},
+			mg:   managedResource(withExternalName(id)),
+			want: errBoom,
+		},
+		"UpdateFailed": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().UpdateRule(gomock.Any()).Return(errBoom)
+			},
+			mg:   managedResource(withExternalName(id)),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"NoRuleOrder": {
+			rule: &rule{observedRuleOrder: 5},
+			mock: update,
+			mg:   managedResource(withExternalName(id)),
+		},
+		"AtRuleOrder": {
+			rule: &rule{ruleOrder: zpaclient.Int32(5), observedRuleOrder: 5},
+			mock: update,
+			mg:   managedResource(withExternalName(id)),
+		},
+		"Reorder": {
+			rule: &rule{ruleOrder: zpaclient.Int32(2), observedRuleOrder: 5},
+			mock: func(m *mockpr.MockClientService) {
+				update(m)
+				m.EXPECT().ReorderRule(reorderParams(2)).Return(nil)
+			},
+			mg: managedResource(withExternalName(id)),
+		},
+		"ReorderFailed": {
+			rule: &rule{ruleOrder: zpaclient.Int32(2), observedRuleOrder: 5},
+			mock: func(m *mockpr.MockClientService) {
+				update(m)
+				m.EXPECT().ReorderRule(gomock.Any()).Return(errBoom)
+			},
+			mg:   managedResource(withExternalName(id)),
+			want: errors.Wrap(errBoom, errReorderFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpr.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &policyrule.External{Client: m, PolicySetID: policySetID, Kind: kind, Rule: ruleFn(tc.rule)}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		rule *rule
+		mock func(m *mockpr.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotRule": {
+			mg:   managedResource(withExternalName(id)),
+			want: errors.New(errNotRule),
+		},
+		"NoExternalName": {
+			rule: &rule{},
+			mg:   managedResource(),
+			want: errors.New(errNoExternalName),
+		},
+		"Success": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().DeleteRule(&policyrule.DeleteRuleParams{
+					Context:     context.Background(),
+					CustomerID:  customerID,
+					PolicySetID: policySetID,
+					RuleID:      id,
+				}).Return(nil)
+			},
+			mg: managedResource(withExternalName(id)),
+		},
+		"AlreadyDeleted": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().DeleteRule(gomock.Any()).Return(errNotFound)
+			},
+			mg: managedResource(withExternalName(id)),
+		},
+		"DeleteFailed": {
+			rule: &rule{},
+			mock: func(m *mockpr.MockClientService) {
+				m.EXPECT().DeleteRule(gomock.Any()).Return(errBoom)
+			},
+			mg:   managedResource(withExternalName(id)),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpr.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &policyrule.External{Client: m, PolicySetID: policySetID, Kind: kind, Rule: ruleFn(tc.rule)}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	type want struct {
+		operator  string
+		ruleOrder *int32
+		li        bool
+	}
+
+	cases := map[string]struct {
+		operator  string
+		ruleOrder *int32
+		obj       *models.PolicyRule
+		want      want
+	}{
+		"Both": {
+			obj:  &models.PolicyRule{Operator: "AND", RuleOrder: 3},
+			want: want{operator: "AND", ruleOrder: zpaclient.Int32(3), li: true},
+		},
+		"Operator": {
+			ruleOrder: zpaclient.Int32(1),
+			obj:       &models.PolicyRule{Operator: "AND", RuleOrder: 3},
+			want:      want{operator: "AND", ruleOrder: zpaclient.Int32(1), li: true},
+		},
+		"RuleOrder": {
+			operator: "OR",
+			obj:      &models.PolicyRule{Operator: "AND", RuleOrder: 3},
+			want:     want{operator: "OR", ruleOrder: zpaclient.Int32(3), li: true},
+		},
+		"AlreadySet": {
+			operator:  "OR",
+			ruleOrder: zpaclient.Int32(1),
+			obj:       &models.PolicyRule{Operator: "AND", RuleOrder: 3},
+			want:      want{operator: "OR", ruleOrder: zpaclient.Int32(1)},
+		},
+		"NotObserved": {
+			obj:  &models.PolicyRule{},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			operator, ruleOrder := tc.operator, tc.ruleOrder
+
+			li := policyrule.LateInitialize(&operator, &ruleOrder, tc.obj)
+			if diff := cmp.Diff(tc.want.li, li); diff != "" {
+				t.Errorf("\nLateInitialize(...): -want late initialized, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.operator, operator); diff != "" {
+				t.Errorf("\nLateInitialize(...): -want operator, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.ruleOrder, ruleOrder); diff != "" {
+				t.Errorf("\nLateInitialize(...): -want rule order, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policyrule is a client of the Policy Set API of ZPA for the rules
+// of each policy type. The policy_set_controller of zpa-go-client can neither
+// look up a policy set by policy type nor reorder its rules.
+package policyrule
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+// Policy types of the policy sets of a customer.
+const (
+	PolicyTypeAccess           = "ACCESS_POLICY"
+	PolicyTypeTimeout          = "TIMEOUT_POLICY"
+	PolicyTypeClientForwarding = "CLIENT_FORWARDING_POLICY"
+	PolicyTypeInspection       = "INSPECTION_POLICY"
+	PolicyTypeIsolation        = "ISOLATION_POLICY"
+)
+
+const (
+	pathPolicySet      = "/mgmtconfig/v1/admin/customers/{customerId}/policySet/policyType/{policyType}"
+	pathRuleCollection = "/mgmtconfig/v1/admin/customers/{customerId}/policySet/{policySetId}/rule"
+	pathRuleObject     = pathRuleCollection + "/{ruleId}"
+	pathRuleReorder    = pathRuleObject + "/reorder/{ruleOrder}"
+)
+
+// ClientService is the interface of the Policy Set API.
+type ClientService interface {
+	GetPolicySet(params *GetPolicySetParams) (*models.PolicySet, error)
+	GetRule(params *GetRuleParams) (*models.PolicyRule, error)
+	AddRule(params *AddRuleParams) (*models.PolicyRule, error)
+	UpdateRule(params *UpdateRuleParams) error
+	ReorderRule(params *ReorderRuleParams) error
+	DeleteRule(params *DeleteRuleParams) error
+}
+
+// GetPolicySetParams are the parameters of GetPolicySet.
+type GetPolicySetParams struct {
+	Context    context.Context
+	CustomerID string
+	PolicyType string
+}
+
+// GetRuleParams are the parameters of GetRule.
+type GetRuleParams struct {
+	Context     context.Context
+	CustomerID  string
+	PolicySetID string
+	RuleID      string
+}
+
+// AddRuleParams are the parameters of AddRule.
+type AddRuleParams struct {
+	Context     context.Context
+	CustomerID  string
+	PolicySetID string
+	Rule        *models.PolicyRule
+}
+
+// UpdateRuleParams are the parameters of UpdateRule.
+type UpdateRuleParams struct {
+	Context     context.Context
+	CustomerID  string
+	PolicySetID string
+	RuleID      string
+	Rule        *models.PolicyRule
+}
+
+// ReorderRuleParams are the parameters of ReorderRule.
+type ReorderRuleParams struct {
+	Context     context.Context
+	CustomerID  string
+	PolicySetID string
+	RuleID      string
+	RuleOrder   int32
+}
+
+// DeleteRuleParams are the parameters of DeleteRule.
+type DeleteRuleParams struct {
+	Context     context.Context
+	CustomerID  string
+	PolicySetID string
+	RuleID      string
+}
+
+// New creates a client of the Policy Set API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the Policy Set API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetPolicySet gets the policy set of a policy type.
+func (c *Client) GetPolicySet(params *GetPolicySetParams) (*models.PolicySet, error) {
+	out := &models.PolicySet{}
+	err := operation.Submit(params.Context, c.transport, "getPolicySetByPolicyTypeUsingGET", http.MethodGet, pathPolicySet, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "policyType": params.PolicyType},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRule gets a rule of a policy set.
+func (c *Client) GetRule(params *GetRuleParams) (*models.PolicyRule, error) {
+	out := &models.PolicyRule{}
+	err := operation.Submit(params.Context, c.transport, "getRuleInPolicySetUsingGET", http.MethodGet, pathRuleObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "policySetId": params.PolicySetID, "ruleId": params.RuleID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddRule adds a rule to a policy set.
+func (c *Client) AddRule(params *AddRuleParams) (*models.PolicyRule, error) {
+	out := &models.PolicyRule{}
+	err := operation.Submit(params.Context, c.transport, "addRuleToPolicySetUsingPOST", http.MethodPost, pathRuleCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "policySetId": params.PolicySetID},
+		Body: params.Rule,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateRule updates a rule of a policy set.
+func (c *Client) UpdateRule(params *UpdateRuleParams) error {
+	return operation.Submit(params.Context, c.transport, "updateRuleToPolicySetUsingPUT", http.MethodPut, pathRuleObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "policySetId": params.PolicySetID, "ruleId": params.RuleID},
+		Body: params.Rule,
+	}, nil)
+}
+
+// ReorderRule moves a rule of a policy set to the supplied rule order. The
+// rule order of a rule cannot be changed by UpdateRule.
+func (c *Client) ReorderRule(params *ReorderRuleParams) error {
+	return operation.Submit(params.Context, c.transport, "reorderRuleUsingPUT", http.MethodPut, pathRuleReorder, &operation.Params{
+		Path: map[string]string{
+			"customerId":  params.CustomerID,
+			"policySetId": params.PolicySetID,
+			"ruleId":      params.RuleID,
+			"ruleOrder":   strconv.Itoa(int(params.RuleOrder)),
+		},
+	}, nil)
+}
+
+// DeleteRule deletes a rule of a policy set.
+func (c *Client) DeleteRule(params *DeleteRuleParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteRuleInPolicySetUsingDELETE", http.MethodDelete, pathRuleObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "policySetId": params.PolicySetID, "ruleId": params.RuleID},
+	}, nil)
+}
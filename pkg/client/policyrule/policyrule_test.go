@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyrule_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+)
+
+func TestClientLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := policyrule.New(srv.Transport(), strfmt.Default)
+
+	ps, err := c.GetPolicySet(&policyrule.GetPolicySetParams{Context: ctx, CustomerID: customerID, PolicyType: policyrule.PolicyTypeTimeout})
+	if err != nil {
+		t.Fatalf("c.GetPolicySet(...): %v", err)
+	}
+	if diff := cmp.Diff(zpafake.PolicySets[policyrule.PolicyTypeTimeout], ps.ID); diff != "" {
+		t.Errorf("c.GetPolicySet(...): -want policy set ID, +got:\n%s\n", diff)
+	}
+	rules := "policySet/" + ps.ID + "/rule"
+
+	// An existing rule, so that the new rule is added second.
+	srv.Seed(customerID, rules, zpafake.Object{"name": "existing"})
+
+	obj, err := c.AddRule(&policyrule.AddRuleParams{Context: ctx, CustomerID: customerID, PolicySetID: ps.ID, Rule: model()})
+	if err != nil {
+		t.Fatalf("c.AddRule(...): %v", err)
+	}
+	if diff := cmp.Diff(int32(2), obj.RuleOrder); diff != "" {
+		t.Errorf("c.AddRule(...): -want rule order, +got:\n%s\n", diff)
+	}
+	ruleID := obj.ID
+
+	update := model()
+	update.Action = "DENY"
+	if err := c.UpdateRule(&policyrule.UpdateRuleParams{Context: ctx, CustomerID: customerID, PolicySetID: ps.ID, RuleID: ruleID, Rule: update}); err != nil {
+		t.Fatalf("c.UpdateRule(...): %v", err)
+	}
+	if err := c.ReorderRule(&policyrule.ReorderRuleParams{Context: ctx, CustomerID: customerID, PolicySetID: ps.ID, RuleID: ruleID, RuleOrder: 1}); err != nil {
+		t.Fatalf("c.ReorderRule(...): %v", err)
+	}
+
+	get := &policyrule.GetRuleParams{Context: ctx, CustomerID: customerID, PolicySetID: ps.ID, RuleID: ruleID}
+	obj, err = c.GetRule(get)
+	if err != nil {
+		t.Fatalf("c.GetRule(...): %v", err)
+	}
+	if obj.Action != "DENY" || obj.RuleOrder != 1 {
+		t.Errorf("c.GetRule(...): want updated and reordered rule, got action %q and rule order %d", obj.Action, obj.RuleOrder)
+	}
+
+	if err := c.DeleteRule(&policyrule.DeleteRuleParams{Context: ctx, CustomerID: customerID, PolicySetID: ps.ID, RuleID: ruleID}); err != nil {
+		t.Fatalf("c.DeleteRule(...): %v", err)
+	}
+	if _, err := c.GetRule(get); !operation.IsNotFound(err) {
+		t.Errorf("c.GetRule(...): want not found error after delete, got %v", err)
+	}
+}
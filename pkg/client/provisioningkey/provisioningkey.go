@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provisioningkey is a client of the Provisioning Key API of ZPA.
+// Provisioning keys enroll App Connectors and Service Edges into a group.
+package provisioningkey
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathCollection = "/mgmtconfig/v1/admin/customers/{customerId}/associationType/{associationType}/provisioningKey"
+	pathObject     = pathCollection + "/{provisioningKeyId}"
+)
+
+// Association types of provisioning keys.
+const (
+	AssociationTypeConnectorGroup   = "CONNECTOR_GRP"
+	AssociationTypeServiceEdgeGroup = "SERVICE_EDGE_GRP"
+)
+
+// A ProvisioningKey of the ZPA API.
+type ProvisioningKey struct {
+	ID                   string `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Enabled              bool   `json:"enabled,omitempty"`
+	MaxUsage             string `json:"maxUsage"`
+	EnrollmentCertID     string `json:"enrollmentCertId"`
+	ZComponentID         string `json:"zcomponentId"`
+	CreationTime         string `json:"creationTime,omitempty"`
+	ModifiedBy           string `json:"modifiedBy,omitempty"`
+	ModifiedTime         string `json:"modifiedTime,omitempty"`
+	ExpirationInEpochSec string `json:"expirationInEpochSec,omitempty"`
+	UsageCount           string `json:"usageCount,omitempty"`
+	EnrollmentCertName   string `json:"enrollmentCertName,omitempty"`
+	ZComponentName       string `json:"zcomponentName,omitempty"`
+
+	// ProvisioningKey is the key itself. It is only returned by the ZPA API,
+	// and ignored when sent.
+	ProvisioningKey string `json:"provisioningKey,omitempty"`
+}
+
+// ClientService is the interface of the Provisioning Key API.
+type ClientService interface {
+	GetProvisioningKey(params *GetProvisioningKeyParams) (*ProvisioningKey, error)
+	AddProvisioningKey(params *AddProvisioningKeyParams) (*ProvisioningKey, error)
+	UpdateProvisioningKey(params *UpdateProvisioningKeyParams) error
+	DeleteProvisioningKey(params *DeleteProvisioningKeyParams) error
+}
+
+// GetProvisioningKeyParams are the parameters of GetProvisioningKey.
+type GetProvisioningKeyParams struct {
+	Context           context.Context
+	CustomerID        string
+	AssociationType   string
+	ProvisioningKeyID string
+}
+
+// AddProvisioningKeyParams are the parameters of AddProvisioningKey.
+type AddProvisioningKeyParams struct {
+	Context         context.Context
+	CustomerID      string
+	AssociationType string
+	ProvisioningKey *ProvisioningKey
+}
+
+// UpdateProvisioningKeyParams are the parameters of UpdateProvisioningKey.
+type UpdateProvisioningKeyParams struct {
+	Context           context.Context
+	CustomerID        string
+	AssociationType   string
+	ProvisioningKeyID string
+	ProvisioningKey   *ProvisioningKey
+}
+
+// DeleteProvisioningKeyParams are the parameters of DeleteProvisioningKey.
+type DeleteProvisioningKeyParams struct {
+	Context           context.Context
+	CustomerID        string
+	AssociationType   string
+	ProvisioningKeyID string
+}
+
+// New creates a client of the Provisioning Key API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the Provisioning Key API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetProvisioningKey gets a provisioning key.
+func (c *Client) GetProvisioningKey(params *GetProvisioningKeyParams) (*ProvisioningKey, error) {
+	out := &ProvisioningKey{}
+	err := operation.Submit(params.Context, c.transport, "getProvisioningKeyUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "associationType": params.AssociationType, "provisioningKeyId": params.ProvisioningKeyID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddProvisioningKey adds a provisioning key.
+func (c *Client) AddProvisioningKey(params *AddProvisioningKeyParams) (*ProvisioningKey, error) {
+	out := &ProvisioningKey{}
+	err := operation.Submit(params.Context, c.transport, "addProvisioningKeyUsingPOST", http.MethodPost, pathCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "associationType": params.AssociationType},
+		Body: params.ProvisioningKey,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateProvisioningKey updates a provisioning key.
+func (c *Client) UpdateProvisioningKey(params *UpdateProvisioningKeyParams) error {
+	return operation.Submit(params.Context, c.transport, "updateProvisioningKeyUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "associationType": params.AssociationType, "provisioningKeyId": params.ProvisioningKeyID},
+		Body: params.ProvisioningKey,
+	}, nil)
+}
+
+// DeleteProvisioningKey deletes a provisioning key.
+func (c *Client) DeleteProvisioningKey(params *DeleteProvisioningKeyParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteProvisioningKeyUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "associationType": params.AssociationType, "provisioningKeyId": params.ProvisioningKeyID},
+	}, nil)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRequestsPerSecond is the default rate of requests to the ZPA
+	// API per tenant.
+	DefaultRequestsPerSecond = 2
+
+	// DefaultBurst is the default number of requests to the ZPA API per
+	// tenant that may exceed the rate.
+	DefaultBurst = 10
+
+	errRateLimit = "cannot wait for ZPA API rate limiter"
+)
+
+// limiters holds the rate limiters of all ZPA tenants this provider talks to.
+// They are shared by all controllers.
+var limiters = &limiterRegistry{limiters: map[string]*rate.Limiter{}}
+
+// A limiterRegistry holds a token bucket rate limiter per ZPA tenant.
+type limiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// Limiter returns the rate limiter of the tenant with the supplied host and
+// customer ID. The limits of an existing limiter are updated to the supplied
+// ones.
+func (r *limiterRegistry) Limiter(host, customerID string, rps, burst int) *rate.Limiter {
+	key := host + "/" + customerID
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.limiters[key]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(rps), burst)
+		r.limiters[key] = l
+		return l
+	}
+	if l.Limit() != rate.Limit(rps) {
+		l.SetLimit(rate.Limit(rps))
+	}
+	if l.Burst() != burst {
+		l.SetBurst(burst)
+	}
+	return l
+}
+
+// rateLimitTransport delays requests to the ZPA API so that they do not
+// exceed the rate limit of the tenant they are sent to. Requests that are not
+// sent to a particular customer, like signing in, are limited per host.
+type rateLimitTransport struct {
+	limiters *limiterRegistry
+	host     string
+	rps      int
+	burst    int
+	next     http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := t.limiters.Limiter(t.host, customerID(req.URL.Path), t.rps, t.burst)
+	if err := l.Wait(req.Context()); err != nil {
+		return nil, errors.Wrap(err, errRateLimit)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// customerID returns the customer ID of a ZPA API path like
+// /mgmtconfig/v1/admin/customers/{customerId}/application, or an empty string
+// if the path does not contain one.
+func customerID(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "customers" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+)
+
+func TestCustomerID(t *testing.T) {
+	cases := map[string]struct {
+		path string
+		want string
+	}{
+		"Application": {path: "/mgmtconfig/v1/admin/customers/72058304855015424/application/1", want: "72058304855015424"},
+		"Collection":  {path: "/mgmtconfig/v1/admin/customers/72058304855015424/", want: "72058304855015424"},
+		"SignIn":      {path: "/signin", want: ""},
+		"NoID":        {path: "/mgmtconfig/v1/admin/customers", want: ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, customerID(tc.path)); diff != "" {
+				t.Errorf("\ncustomerID(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestLimiterRegistry(t *testing.T) {
+	r := &limiterRegistry{limiters: map[string]*rate.Limiter{}}
+
+	a := r.Limiter("config.private.zscaler.com", "1", 2, 10)
+	if b := r.Limiter("config.private.zscaler.com", "1", 5, 20); a != b {
+		t.Errorf("r.Limiter(...): want the limiter of a tenant to be shared")
+	}
+	if a.Limit() != 5 || a.Burst() != 20 {
+		t.Errorf("r.Limiter(...): want limits 5/20, got %v/%d", a.Limit(), a.Burst())
+	}
+	if c := r.Limiter("config.private.zscaler.com", "2", 2, 10); a == c {
+		t.Errorf("r.Limiter(...): want a limiter per tenant")
+	}
+}
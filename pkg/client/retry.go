@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 4
+	defaultRetryBackoff = 500 * time.Millisecond
+	defaultRetryMaxWait = 30 * time.Second
+
+	retryReasonNetworkError = "NetworkError"
+)
+
+// idempotentMethods may be retried after the ZPA API failed to process them,
+// because repeating them has no further effect.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// retryTransport retries requests to the ZPA API that failed transiently.
+// Requests answered with 429 Too Many Requests were not processed and are
+// retried regardless of their method. Idempotent requests are also retried
+// on network errors and on 502, 503 and 504 responses. Retries are delayed by
+// an exponential, jittered backoff unless the response carries a Retry-After
+// header.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+	maxWait    time.Duration
+
+	// jitter returns a random duration in [0, d).
+	jitter func(d time.Duration) time.Duration
+	now    func() time.Time
+}
+
+func newRetryTransport(next http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultRetryBackoff,
+		maxWait:    defaultRetryMaxWait,
+		jitter:     func(d time.Duration) time.Duration { return time.Duration(rand.Int63n(int64(d))) }, // nolint:gosec
+		now:        time.Now,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(r)
+
+		reason, retry := t.shouldRetry(req, resp, err)
+		if !retry || attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		wait := t.wait(attempt, resp)
+		if resp != nil {
+			closeBody(resp.Body)
+		}
+
+		if req.GetBody != nil {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return nil, berr
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		retries.WithLabelValues(req.Method, reason).Inc()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry returns whether and why the supplied request should be retried.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) (string, bool) {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return "", false
+		}
+		return retryReasonNetworkError, idempotentMethods[req.Method]
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return strconv.Itoa(resp.StatusCode), true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return strconv.Itoa(resp.StatusCode), idempotentMethods[req.Method]
+	}
+	return "", false
+}
+
+// wait returns how long to wait before the supplied retry attempt.
+func (t *retryTransport) wait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := t.retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := t.backoff << uint(attempt)
+	if d <= 0 || d > t.maxWait {
+		d = t.maxWait
+	}
+	// Wait at least half the backoff, so that concurrent clients spread out
+	// without retrying immediately.
+	return d/2 + t.jitter(d/2+1)
+}
+
+// retryAfter parses a Retry-After header, which is either a number of seconds
+// or an HTTP date. The returned duration is capped at the maximum wait.
+func (t *retryTransport) retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	var d time.Duration
+	if s, err := strconv.Atoi(v); err == nil {
+		d = time.Duration(s) * time.Second
+	} else if at, err := http.ParseTime(v); err == nil {
+		d = at.Sub(t.now())
+	} else {
+		return 0, false
+	}
+
+	switch {
+	case d < 0:
+		return 0, true
+	case d > t.maxWait:
+		return t.maxWait, true
+	}
+	return d, true
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+type roundTripFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripFn) RoundTrip(req *http.Request) (*http.Response, error) { return fn(req) }
+
+func TestRetryTransport(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		attempts int
+		status   int
+		bodies   []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		method    string
+		body      string
+		responses []int
+		errs      []error
+		want      want
+	}{
+		"NoRetryOnSuccess": {
+			method:    http.MethodGet,
+			responses: []int{http.StatusOK},
+			want:      want{attempts: 1, status: http.StatusOK},
+		},
+		"RetryIdempotentOnServiceUnavailable": {
+			method:    http.MethodGet,
+			responses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			want:      want{attempts: 3, status: http.StatusOK},
+		},
+		"NoRetryPostOnServiceUnavailable": {
+			method:    http.MethodPost,
+			body:      "{}",
+			responses: []int{http.StatusServiceUnavailable},
+			want:      want{attempts: 1, status: http.StatusServiceUnavailable, bodies: []string{"{}"}},
+		},
+		"RetryPostOnTooManyRequests": {
+			method:    http.MethodPost,
+			body:      "{}",
+			responses: []int{http.StatusTooManyRequests, http.StatusCreated},
+			want:      want{attempts: 2, status: http.StatusCreated, bodies: []string{"{}", "{}"}},
+		},
+		"RetryIdempotentOnNetworkError": {
+			method:    http.MethodDelete,
+			responses: []int{0, http.StatusNoContent},
+			errs:      []error{errBoom, nil},
+			want:      want{attempts: 2, status: http.StatusNoContent},
+		},
+		"GiveUpAfterMaxRetries": {
+			method:    http.MethodGet,
+			responses: []int{429, 429, 429, 429, 429},
+			want:      want{attempts: 3, status: http.StatusTooManyRequests},
+		},
+		"NoRetryOnNotFound": {
+			method:    http.MethodGet,
+			responses: []int{http.StatusNotFound},
+			want:      want{attempts: 1, status: http.StatusNotFound},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var bodies []string
+			attempts := 0
+			next := roundTripFn(func(req *http.Request) (*http.Response, error) {
+				i := attempts
+				attempts++
+				if req.Body != nil {
+					b, _ := ioutil.ReadAll(req.Body)
+					bodies = append(bodies, string(b))
+				}
+				if i < len(tc.errs) && tc.errs[i] != nil {
+					return nil, tc.errs[i]
+				}
+				return &http.Response{StatusCode: tc.responses[i], Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			})
+
+			rt := &retryTransport{
+				next:       next,
+				maxRetries: 2,
+				backoff:    time.Millisecond,
+				maxWait:    time.Millisecond,
+				jitter:     func(time.Duration) time.Duration { return 0 },
+				now:        time.Now,
+			}
+
+			req, _ := http.NewRequest(tc.method, "https://config.private.zscaler.com/", nil)
+			if tc.body != "" {
+				req, _ = http.NewRequest(tc.method, "https://config.private.zscaler.com/", strings.NewReader(tc.body))
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("\nrt.RoundTrip(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.attempts, attempts); diff != "" {
+				t.Errorf("\nrt.RoundTrip(...): -want attempts, +got attempts:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, resp.StatusCode); diff != "" {
+				t.Errorf("\nrt.RoundTrip(...): -want status, +got status:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, bodies); diff != "" {
+				t.Errorf("\nrt.RoundTrip(...): -want bodies, +got bodies:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	rt := &retryTransport{maxWait: 30 * time.Second, now: func() time.Time { return now }}
+
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+
+	cases := map[string]struct {
+		header string
+		want   want
+	}{
+		"Empty":   {header: "", want: want{}},
+		"Invalid": {header: "soon", want: want{}},
+		"Seconds": {header: "3", want: want{d: 3 * time.Second, ok: true}},
+		"Date":    {header: now.Add(5 * time.Second).Format(http.TimeFormat), want: want{d: 5 * time.Second, ok: true}},
+		"Past":    {header: now.Add(-time.Minute).Format(http.TimeFormat), want: want{d: 0, ok: true}},
+		"Capped":  {header: "120", want: want{d: 30 * time.Second, ok: true}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := rt.retryAfter(tc.header)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nrt.retryAfter(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
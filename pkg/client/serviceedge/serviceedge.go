@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package serviceedge is a client of the Service Edge API of ZPA. Service
+// Edges enroll themselves using a provisioning key, so they can only be read,
+// updated and deleted.
+package serviceedge
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const pathObject = "/mgmtconfig/v1/admin/customers/{customerId}/serviceEdge/{serviceEdgeId}"
+
+// A ServiceEdge of the ZPA API.
+type ServiceEdge struct {
+	ID                       string `json:"id,omitempty"`
+	Name                     string `json:"name"`
+	Description              string `json:"description,omitempty"`
+	Enabled                  bool   `json:"enabled"`
+	ServiceEdgeGroupID       string `json:"serviceEdgeGroupId,omitempty"`
+	ServiceEdgeGroupName     string `json:"serviceEdgeGroupName,omitempty"`
+	ControlChannelStatus     string `json:"controlChannelStatus,omitempty"`
+	CtrlBrokerName           string `json:"ctrlBrokerName,omitempty"`
+	CurrentVersion           string `json:"currentVersion,omitempty"`
+	ExpectedVersion          string `json:"expectedVersion,omitempty"`
+	UpgradeStatus            string `json:"upgradeStatus,omitempty"`
+	LastBrokerConnectTime    string `json:"lastBrokerConnectTime,omitempty"`
+	LastBrokerDisconnectTime string `json:"lastBrokerDisconnectTime,omitempty"`
+	Platform                 string `json:"platform,omitempty"`
+	PrivateIP                string `json:"privateIp,omitempty"`
+	PublicIP                 string `json:"publicIp,omitempty"`
+	Location                 string `json:"location,omitempty"`
+	CreationTime             string `json:"creationTime,omitempty"`
+	ModifiedBy               string `json:"modifiedBy,omitempty"`
+	ModifiedTime             string `json:"modifiedTime,omitempty"`
+}
+
+// ClientService is the interface of the Service Edge API.
+type ClientService interface {
+	GetServiceEdge(params *GetServiceEdgeParams) (*ServiceEdge, error)
+	UpdateServiceEdge(params *UpdateServiceEdgeParams) error
+	DeleteServiceEdge(params *DeleteServiceEdgeParams) error
+}
+
+// GetServiceEdgeParams are the parameters of GetServiceEdge.
+type GetServiceEdgeParams struct {
+	Context       context.Context
+	CustomerID    string
+	ServiceEdgeID string
+}
+
+// UpdateServiceEdgeParams are the parameters of UpdateServiceEdge.
+type UpdateServiceEdgeParams struct {
+	Context       context.Context
+	CustomerID    string
+	ServiceEdgeID string
+	ServiceEdge   *ServiceEdge
+}
+
+// DeleteServiceEdgeParams are the parameters of DeleteServiceEdge.
+type DeleteServiceEdgeParams struct {
+	Context       context.Context
+	CustomerID    string
+	ServiceEdgeID string
+}
+
+// New creates a client of the Service Edge API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the Service Edge API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetServiceEdge gets a Service Edge.
+func (c *Client) GetServiceEdge(params *GetServiceEdgeParams) (*ServiceEdge, error) {
+	out := &ServiceEdge{}
+	err := operation.Submit(params.Context, c.transport, "getServiceEdgeUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeId": params.ServiceEdgeID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateServiceEdge updates a Service Edge.
+func (c *Client) UpdateServiceEdge(params *UpdateServiceEdgeParams) error {
+	return operation.Submit(params.Context, c.transport, "updateServiceEdgeUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeId": params.ServiceEdgeID},
+		Body: params.ServiceEdge,
+	}, nil)
+}
+
+// DeleteServiceEdge deletes a Service Edge.
+func (c *Client) DeleteServiceEdge(params *DeleteServiceEdgeParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteServiceEdgeUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeId": params.ServiceEdgeID},
+	}, nil)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package serviceedgegroup is a client of the Service Edge Group API of
+// ZPA, which zpa-go-client does not cover.
+package serviceedgegroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	pathCollection = "/mgmtconfig/v1/admin/customers/{customerId}/serviceEdgeGroup"
+	pathObject     = pathCollection + "/{serviceEdgeGroupId}"
+)
+
+// Values of ServiceEdgeGroup.IsPublic, which the ZPA API encodes as a string.
+const (
+	IsPublicTrue  = "TRUE"
+	IsPublicFalse = "FALSE"
+)
+
+// A ServiceEdgeGroup of the ZPA API.
+type ServiceEdgeGroup struct {
+	ID                     string           `json:"id,omitempty"`
+	Name                   string           `json:"name"`
+	Description            string           `json:"description,omitempty"`
+	Enabled                bool             `json:"enabled"`
+	CityCountry            string           `json:"cityCountry,omitempty"`
+	CountryCode            string           `json:"countryCode,omitempty"`
+	Location               string           `json:"location"`
+	Latitude               string           `json:"latitude"`
+	Longitude              string           `json:"longitude"`
+	IsPublic               string           `json:"isPublic,omitempty"`
+	VersionProfileID       string           `json:"versionProfileId,omitempty"`
+	VersionProfileName     string           `json:"versionProfileName,omitempty"`
+	OverrideVersionProfile bool             `json:"overrideVersionProfile"`
+	UpgradeDay             string           `json:"upgradeDay,omitempty"`
+	UpgradeTimeInSecs      string           `json:"upgradeTimeInSecs,omitempty"`
+	TrustedNetworks        []TrustedNetwork `json:"trustedNetworks"`
+	CreationTime           string           `json:"creationTime,omitempty"`
+	ModifiedBy             string           `json:"modifiedBy,omitempty"`
+	ModifiedTime           string           `json:"modifiedTime,omitempty"`
+}
+
+// A TrustedNetwork of a ServiceEdgeGroup. Only its ID is sent to the ZPA API.
+type TrustedNetwork struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ClientService is the interface of the Service Edge Group API.
+type ClientService interface {
+	GetServiceEdgeGroup(params *GetServiceEdgeGroupParams) (*ServiceEdgeGroup, error)
+	AddServiceEdgeGroup(params *AddServiceEdgeGroupParams) (*ServiceEdgeGroup, error)
+	UpdateServiceEdgeGroup(params *UpdateServiceEdgeGroupParams) error
+	DeleteServiceEdgeGroup(params *DeleteServiceEdgeGroupParams) error
+}
+
+// GetServiceEdgeGroupParams are the parameters of GetServiceEdgeGroup.
+type GetServiceEdgeGroupParams struct {
+	Context            context.Context
+	CustomerID         string
+	ServiceEdgeGroupID string
+}
+
+// AddServiceEdgeGroupParams are the parameters of AddServiceEdgeGroup.
+type AddServiceEdgeGroupParams struct {
+	Context          context.Context
+	CustomerID       string
+	ServiceEdgeGroup *ServiceEdgeGroup
+}
+
+// UpdateServiceEdgeGroupParams are the parameters of
+// UpdateServiceEdgeGroup.
+type UpdateServiceEdgeGroupParams struct {
+	Context            context.Context
+	CustomerID         string
+	ServiceEdgeGroupID string
+	ServiceEdgeGroup   *ServiceEdgeGroup
+}
+
+// DeleteServiceEdgeGroupParams are the parameters of
+// DeleteServiceEdgeGroup.
+type DeleteServiceEdgeGroupParams struct {
+	Context            context.Context
+	CustomerID         string
+	ServiceEdgeGroupID string
+}
+
+// New creates a client of the Service Edge Group API.
+func New(transport runtime.ClientTransport, _ strfmt.Registry) ClientService {
+	return &Client{transport: transport}
+}
+
+// Client of the Service Edge Group API.
+type Client struct {
+	transport runtime.ClientTransport
+}
+
+// GetServiceEdgeGroup gets a Service Edge Group.
+func (c *Client) GetServiceEdgeGroup(params *GetServiceEdgeGroupParams) (*ServiceEdgeGroup, error) {
+	out := &ServiceEdgeGroup{}
+	err := operation.Submit(params.Context, c.transport, "getServiceEdgeGroupUsingGET", http.MethodGet, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeGroupId": params.ServiceEdgeGroupID},
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddServiceEdgeGroup adds a Service Edge Group.
+func (c *Client) AddServiceEdgeGroup(params *AddServiceEdgeGroupParams) (*ServiceEdgeGroup, error) {
+	out := &ServiceEdgeGroup{}
+	err := operation.Submit(params.Context, c.transport, "addServiceEdgeGroupUsingPOST", http.MethodPost, pathCollection, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID},
+		Body: params.ServiceEdgeGroup,
+	}, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateServiceEdgeGroup updates a Service Edge Group.
+func (c *Client) UpdateServiceEdgeGroup(params *UpdateServiceEdgeGroupParams) error {
+	return operation.Submit(params.Context, c.transport, "updateServiceEdgeGroupUsingPUT", http.MethodPut, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeGroupId": params.ServiceEdgeGroupID},
+		Body: params.ServiceEdgeGroup,
+	}, nil)
+}
+
+// DeleteServiceEdgeGroup deletes a Service Edge Group.
+func (c *Client) DeleteServiceEdgeGroup(params *DeleteServiceEdgeGroupParams) error {
+	return operation.Submit(params.Context, c.transport, "deleteServiceEdgeGroupUsingDELETE", http.MethodDelete, pathObject, &operation.Params{
+		Path: map[string]string{"customerId": params.CustomerID, "serviceEdgeGroupId": params.ServiceEdgeGroupID},
+	}, nil)
+}
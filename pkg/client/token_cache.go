@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+const (
+	// tokenRefreshWindow is how long before its expiry a cached bearer token
+	// is considered stale and proactively refreshed.
+	tokenRefreshWindow = 5 * time.Minute
+)
+
+// tokens caches ZPA bearer tokens for all ProviderConfigs of this provider.
+var tokens = newTokenCache()
+
+// A tokenCache caches ZPA bearer tokens per ProviderConfig.
+type tokenCache struct {
+	mu      sync.Mutex
+	entries map[string]*tokenEntry
+	now     func() time.Time
+}
+
+// A tokenEntry is the cached bearer token of a single ProviderConfig. Its
+// mutex is held while signing in, so that concurrent Connect calls for the
+// same ProviderConfig result in a single sign-in.
+type tokenEntry struct {
+	mu        sync.Mutex
+	key       string
+	token     string
+	refreshAt time.Time
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{entries: map[string]*tokenEntry{}, now: time.Now}
+}
+
+// A signInFn signs in to ZPA and returns the issued credentials.
+type signInFn func() (*v1alpha1.RespCredentials, error)
+
+// Token returns the cached bearer token of the named ProviderConfig if it was
+// issued for the supplied key and is not about to expire. Otherwise it signs
+// in and caches the new token.
+func (c *tokenCache) Token(name, key string, signIn signInFn) (string, error) {
+	e := c.entry(name)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.key == key && e.token != "" && c.now().Before(e.refreshAt) {
+		tokenCacheRequests.WithLabelValues(name, resultHit).Inc()
+		return e.token, nil
+	}
+	tokenCacheRequests.WithLabelValues(name, resultMiss).Inc()
+
+	creds, err := signIn()
+	if err != nil {
+		e.token = ""
+		return "", err
+	}
+
+	e.key = key
+	e.token = creds.AccessToken
+	e.refreshAt = c.now().Add(refreshAfter(creds.ExpiresIn))
+	return e.token, nil
+}
+
+// Invalidate drops the cached bearer token of the named ProviderConfig, but
+// only if it is still the supplied token. A token that was already refreshed
+// by another caller is kept.
+func (c *tokenCache) Invalidate(name, token string) {
+	e := c.entry(name)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.token == token {
+		e.token = ""
+	}
+}
+
+func (c *tokenCache) entry(name string) *tokenEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[name]
+	if !ok {
+		e = &tokenEntry{}
+		c.entries[name] = e
+	}
+	return e
+}
+
+// refreshAfter returns how long a token that expires in the supplied number
+// of seconds may be used before it should be refreshed. Tokens with an
+// unknown lifetime are not reused.
+func refreshAfter(expiresIn string) time.Duration {
+	s, err := strconv.ParseInt(expiresIn, 10, 64)
+	if err != nil || s <= 0 {
+		return 0
+	}
+	lifetime := time.Duration(s) * time.Second
+
+	window := tokenRefreshWindow
+	if window > lifetime/2 {
+		window = lifetime / 2
+	}
+	return lifetime - window
+}
+
+// tokenCacheKey identifies the exact ProviderConfig and credentials a token
+// was issued for, so that a token is not reused once either of them changes.
+func tokenCacheKey(pc *v1alpha1.ProviderConfig, clientID, clientSecret string) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(clientID))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(clientSecret))
+	return pc.GetResourceVersion() + "/" + hex.EncodeToString(h.Sum(nil))
+}
+
+// invalidatingTransport drops a cached bearer token as soon as the ZPA API
+// rejects it, so that the next Connect signs in again.
+type invalidatingTransport struct {
+	name  string
+	token string
+	cache *tokenCache
+	next  http.RoundTripper
+}
+
+func (t *invalidatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.cache.Invalidate(t.name, t.token)
+	}
+	return resp, err
+}
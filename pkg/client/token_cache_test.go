@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+// signInServer is an httptest server that issues a new bearer token on every
+// sign-in and rejects all other requests with 401 Unauthorized.
+type signInServer struct {
+	*httptest.Server
+	signIns   int32
+	expiresIn string
+}
+
+func newSignInServer(expiresIn string) *signInServer {
+	s := &signInServer{expiresIn: expiresIn}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/signin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n := atomic.AddInt32(&s.signIns, 1)
+		_ = json.NewEncoder(w).Encode(v1alpha1.RespCredentials{
+			TokenType:   "Bearer",
+			AccessToken: fmt.Sprintf("token-%d", n),
+			ExpiresIn:   s.expiresIn,
+		})
+	}))
+	return s
+}
+
+func (s *signInServer) host() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+func TestTokenCache(t *testing.T) {
+	pc := func(resourceVersion string) *v1alpha1.ProviderConfig {
+		pc := &v1alpha1.ProviderConfig{}
+		pc.SetName("example")
+		pc.SetResourceVersion(resourceVersion)
+		return pc
+	}
+	key := tokenCacheKey(pc("1"), "client", "secret")
+
+	// A step gets a token for a key, after the clock advanced and the
+	// previous token was optionally rejected by the ZPA API.
+	type step struct {
+		key      string
+		advance  time.Duration
+		rejected bool
+		want     string
+	}
+
+	cases := map[string]struct {
+		expiresIn   string
+		steps       []step
+		wantSignIns int32
+	}{
+		"Reused": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: key, advance: 30 * time.Minute, want: "token-1"},
+			},
+			wantSignIns: 1,
+		},
+		"RefreshedBeforeExpiry": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: key, advance: 55 * time.Minute, want: "token-2"},
+				{key: key, want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"ShortLivedRefreshedAtHalfLife": {
+			expiresIn: "120",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: key, advance: 59 * time.Second, want: "token-1"},
+				{key: key, advance: time.Second, want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"UnknownLifetimeNotReused": {
+			expiresIn: "",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: key, want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"InvalidatedOnUnauthorized": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: key, rejected: true, want: "token-2"},
+				{key: key, want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"ResourceVersionChanged": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: tokenCacheKey(pc("2"), "client", "secret"), want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"ClientSecretChanged": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: tokenCacheKey(pc("1"), "client", "rotated"), want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+		"ClientIDChanged": {
+			expiresIn: "3600",
+			steps: []step{
+				{key: key, want: "token-1"},
+				{key: tokenCacheKey(pc("1"), "other", "secret"), want: "token-2"},
+			},
+			wantSignIns: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := newSignInServer(tc.expiresIn)
+			defer srv.Close()
+
+			now := time.Unix(1633046400, 0)
+			c := newTokenCache()
+			c.now = func() time.Time { return now }
+
+			hc := srv.Client()
+			signInFn := func() (*v1alpha1.RespCredentials, error) {
+				return signIn(context.Background(), hc, srv.host(), "client", "secret")
+			}
+
+			token := ""
+			for i, s := range tc.steps {
+				now = now.Add(s.advance)
+				if s.rejected {
+					rt := &invalidatingTransport{name: "example", token: token, cache: c, next: hc.Transport}
+					req, _ := http.NewRequest(http.MethodGet, srv.URL+"/mgmtconfig/v1/admin/customers/1/application", nil)
+					resp, err := rt.RoundTrip(req)
+					if err != nil {
+						t.Fatalf("step %d: rt.RoundTrip(...): %v", i, err)
+					}
+					closeBody(resp.Body)
+				}
+
+				got, err := c.Token("example", s.key, signInFn)
+				if err != nil {
+					t.Fatalf("step %d: c.Token(...): %v", i, err)
+				}
+				if diff := cmp.Diff(s.want, got); diff != "" {
+					t.Errorf("step %d: c.Token(...): -want, +got:\n%s\n", i, diff)
+				}
+				token = got
+			}
+
+			if diff := cmp.Diff(tc.wantSignIns, atomic.LoadInt32(&srv.signIns)); diff != "" {
+				t.Errorf("c.Token(...): -want sign-ins, +got sign-ins:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTokenCacheInvalidate(t *testing.T) {
+	cases := map[string]struct {
+		cached      string
+		invalidated string
+		want        string
+	}{
+		"RejectedToken": {
+			cached:      "token-1",
+			invalidated: "token-1",
+			want:        "",
+		},
+		"AlreadyRefreshed": {
+			cached:      "token-2",
+			invalidated: "token-1",
+			want:        "token-2",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTokenCache()
+			c.entry("example").token = tc.cached
+
+			c.Invalidate("example", tc.invalidated)
+			if diff := cmp.Diff(tc.want, c.entry("example").token); diff != "" {
+				t.Errorf("c.Invalidate(...): -want cached token, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
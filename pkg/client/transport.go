@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+const (
+	errParseProxyURL        = "cannot parse proxy URL"
+	errGetCABundleSecret    = "cannot get CA bundle secret"
+	errNoCABundle           = "CA bundle secret contains no PEM encoded certificates in key %q"
+	errGetClientCertSecret  = "cannot get client certificate secret"
+	errLoadClientCert       = "cannot load client certificate"
+	errSystemCertPool       = "cannot load system certificate pool"
+	errDefaultTransportType = "http.DefaultTransport is not an *http.Transport"
+)
+
+// transports caches the HTTP transports of all ProviderConfigs of this
+// provider, so that connections to ZPA are reused across Connect calls.
+var transports = &transportCache{entries: map[string]*transportEntry{}}
+
+// A transportCache caches an HTTP transport per ProviderConfig.
+type transportCache struct {
+	mu      sync.Mutex
+	entries map[string]*transportEntry
+}
+
+type transportEntry struct {
+	key       string
+	transport *http.Transport
+}
+
+// Transport returns the HTTP transport for the supplied ProviderConfig. A
+// ProviderConfig that configures neither a proxy nor TLS uses the default
+// transport.
+func (c *transportCache) Transport(ctx context.Context, kube client.Client, pc *v1alpha1.ProviderConfig) (http.RoundTripper, error) {
+	if pc.Spec.ProxyURL == nil && pc.Spec.TLS == nil {
+		return http.DefaultTransport, nil
+	}
+
+	tc, h, err := tlsConfig(ctx, kube, pc.Spec.TLS)
+	if err != nil {
+		return nil, err
+	}
+	key := pc.GetResourceVersion() + "/" + h
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[pc.GetName()]; ok {
+		if e.key == key {
+			return e.transport, nil
+		}
+		e.transport.CloseIdleConnections()
+	}
+
+	dt, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New(errDefaultTransportType)
+	}
+	t := dt.Clone()
+	t.TLSClientConfig = tc
+
+	if pc.Spec.ProxyURL != nil {
+		u, err := url.Parse(*pc.Spec.ProxyURL)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseProxyURL)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	c.entries[pc.GetName()] = &transportEntry{key: key, transport: t}
+	return t, nil
+}
+
+// tlsConfig builds the TLS configuration of a ProviderConfig. It also returns
+// a hash of the CA bundle and client certificate it was built from.
+func tlsConfig(ctx context.Context, kube client.Client, cfg *v1alpha1.TLSConfig) (*tls.Config, string, error) {
+	tc := &tls.Config{MinVersion: tls.VersionTLS12}
+	h := sha256.New()
+
+	if cfg == nil {
+		return tc, hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	if ref := cfg.CABundleSecretRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, "", errors.Wrap(err, errGetCABundleSecret)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, "", errors.Wrap(err, errSystemCertPool)
+		}
+		if !pool.AppendCertsFromPEM(s.Data[ref.Key]) {
+			return nil, "", errors.Errorf(errNoCABundle, ref.Key)
+		}
+		tc.RootCAs = pool
+		_, _ = h.Write(s.Data[ref.Key])
+	}
+
+	if ref := cfg.ClientCertificateSecretRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, "", errors.Wrap(err, errGetClientCertSecret)
+		}
+
+		cert, err := tls.X509KeyPair(s.Data[corev1.TLSCertKey], s.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, "", errors.Wrap(err, errLoadClientCert)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+		_, _ = h.Write(s.Data[corev1.TLSCertKey])
+		_, _ = h.Write(s.Data[corev1.TLSPrivateKeyKey])
+	}
+
+	return tc, hex.EncodeToString(h.Sum(nil)), nil
+}
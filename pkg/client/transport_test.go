@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+)
+
+func TestTransport(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	errBoom := errors.New("boom")
+
+	kube := func(data map[string][]byte, err error) client.Client {
+		return &test.MockClient{
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				if s, ok := obj.(*corev1.Secret); ok {
+					s.Data = data
+				}
+				return err
+			},
+		}
+	}
+	providerConfig := func(resourceVersion string, tls *v1alpha1.TLSConfig, proxyURL *string) *v1alpha1.ProviderConfig {
+		pc := &v1alpha1.ProviderConfig{Spec: v1alpha1.ProviderConfigSpec{TLS: tls, ProxyURL: proxyURL}}
+		pc.SetName("example")
+		pc.SetResourceVersion(resourceVersion)
+		return pc
+	}
+	caBundleRef := &v1alpha1.TLSConfig{CABundleSecretRef: &xpv1.SecretKeySelector{
+		Key:             "ca.crt",
+		SecretReference: xpv1.SecretReference{Name: "zpa-ca", Namespace: "crossplane-system"},
+	}}
+	clientCertRef := &v1alpha1.TLSConfig{ClientCertificateSecretRef: &xpv1.SecretReference{Name: "zpa-client", Namespace: "crossplane-system"}}
+
+	cases := map[string]struct {
+		kube    client.Client
+		pc      *v1alpha1.ProviderConfig
+		want    error
+		trusted bool
+	}{
+		"Default": {
+			kube: kube(nil, nil),
+			pc:   providerConfig("1", nil, nil),
+		},
+		"CABundle": {
+			kube:    kube(map[string][]byte{"ca.crt": caBundle}, nil),
+			pc:      providerConfig("1", caBundleRef, nil),
+			trusted: true,
+		},
+		"NoPEMCertificates": {
+			kube: kube(map[string][]byte{"ca.crt": []byte("not a certificate")}, nil),
+			pc:   providerConfig("1", caBundleRef, nil),
+			want: errors.Errorf(errNoCABundle, "ca.crt"),
+		},
+		"GetCABundleSecretFailed": {
+			kube: kube(nil, errBoom),
+			pc:   providerConfig("1", caBundleRef, nil),
+			want: errors.Wrap(errBoom, errGetCABundleSecret),
+		},
+		"GetClientCertSecretFailed": {
+			kube: kube(nil, errBoom),
+			pc:   providerConfig("1", clientCertRef, nil),
+			want: errors.Wrap(errBoom, errGetClientCertSecret),
+		},
+		"InvalidClientCert": {
+			kube: kube(map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")}, nil),
+			pc:   providerConfig("1", clientCertRef, nil),
+			want: errors.Wrap(errors.New("tls: failed to find any PEM data in certificate input"), errLoadClientCert),
+		},
+		"InvalidProxyURL": {
+			kube: kube(nil, nil),
+			pc:   providerConfig("1", nil, String("://proxy")),
+			want: errors.Wrap(errors.New(`parse "://proxy": missing protocol scheme`), errParseProxyURL),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &transportCache{entries: map[string]*transportEntry{}}
+
+			rt, err := c.Transport(context.Background(), tc.kube, tc.pc)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("c.Transport(...): -want error, +got error:\n%s\n", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			resp, err := (&http.Client{Transport: rt}).Get(srv.URL)
+			if err == nil {
+				closeBody(resp.Body)
+			}
+			if diff := cmp.Diff(tc.trusted, err == nil); diff != "" {
+				t.Errorf("c.Transport(...): -want server certificate trusted, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTransportCache(t *testing.T) {
+	data := map[string][]byte{"ca.crt": nil}
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"ca.crt": data["ca.crt"]}
+			return nil
+		},
+	}
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	pc := &v1alpha1.ProviderConfig{Spec: v1alpha1.ProviderConfigSpec{TLS: &v1alpha1.TLSConfig{CABundleSecretRef: &xpv1.SecretKeySelector{
+		Key:             "ca.crt",
+		SecretReference: xpv1.SecretReference{Name: "zpa-ca", Namespace: "crossplane-system"},
+	}}}}
+	pc.SetName("example")
+	pc.SetResourceVersion("1")
+
+	c := &transportCache{entries: map[string]*transportEntry{}}
+	transport := func() http.RoundTripper {
+		t.Helper()
+		rt, err := c.Transport(context.Background(), kube, pc)
+		if err != nil {
+			t.Fatalf("c.Transport(...): %v", err)
+		}
+		return rt
+	}
+
+	data["ca.crt"] = caBundle
+	first := transport()
+	if transport() != first {
+		t.Errorf("c.Transport(...): want the transport to be reused for an unchanged ProviderConfig")
+	}
+
+	pc.SetResourceVersion("2")
+	second := transport()
+	if second == first {
+		t.Errorf("c.Transport(...): want a new transport when the ProviderConfig changed")
+	}
+
+	// Text outside of PEM blocks is ignored, but changes the CA bundle.
+	data["ca.crt"] = append([]byte("# rotated\n"), caBundle...)
+	third := transport()
+	if third == second {
+		t.Errorf("c.Transport(...): want a new transport when the CA bundle changed")
+	}
+	resp, err := (&http.Client{Transport: third}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get(...): want the changed CA bundle to be trusted, got %v", err)
+	}
+	closeBody(resp.Body)
+}
@@ -19,20 +19,21 @@ package client
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -45,26 +46,57 @@ const (
 	errNoProviderConfigRef            = "no providerConfigRef is given"
 	errCannotGetProvider              = "cannot get referenced Provider"
 	errCannotTrackProviderConfigUsage = "cannot track ProviderConfig usage"
-	errOnlySecretSourceAllowed        = "only Secret supported as Source"
-	errExtractSecret                  = "cannot extract credentials from secret"
+	errExtractClientID                = "cannot extract ClientID credentials"
+	errExtractClientSecret            = "cannot extract ClientSecret credentials"
 	errExtractSecretKey               = "cannot extract secret key"
-	errGetCredentialsSecret           = "cannot get credentials secret"
 	errInvalidSecretData              = "'%s' is required in secret data"
+	errNoAccessToken                  = "sign-in response contains no access token"
+
+	reasonSignInFailed event.Reason = "CannotSignIn"
 )
 
-// GetConfig constructs an *httptransport.Runtime that can be used to connect to Zscaler ZPA
+// A ConfigOption configures how the ZPA API is connected to.
+type ConfigOption func(*configOptions)
+
+type configOptions struct {
+	recorder event.Recorder
+	logger   logging.Logger
+}
+
+// WithRecorder records an event on the ProviderConfig when signing in with
+// its credentials fails.
+func WithRecorder(r event.Recorder) ConfigOption {
+	return func(o *configOptions) {
+		o.recorder = r
+	}
+}
+
+// WithLogger logs all requests to and responses from the ZPA API if the
+// ProviderConfig enables debugging.
+func WithLogger(l logging.Logger) ConfigOption {
+	return func(o *configOptions) {
+		o.logger = l
+	}
+}
+
+// GetConfig constructs a runtime.ClientTransport that can be used to connect to Zscaler ZPA
 // API by the ZPA client.
-func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*httptransport.Runtime, error) {
+func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, opts ...ConfigOption) (runtime.ClientTransport, error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg)
+		return UseProviderConfig(ctx, c, mg, opts...)
 	default:
 		return nil, errors.New(errNoProviderConfigRef)
 	}
 }
 
-// UseProviderConfig to produce a *httptransport.Runtime that can be used to connect to Zscaler ZPA.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (*httptransport.Runtime, error) { // nolint:gocyclo
+// UseProviderConfig to produce a runtime.ClientTransport that can be used to connect to Zscaler ZPA.
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, opts ...ConfigOption) (runtime.ClientTransport, error) { // nolint:gocyclo
+	o := &configOptions{recorder: event.NewNopRecorder(), logger: logging.NewNopLogger()}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	pc := &v1alpha1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, errCannotGetProvider)
@@ -75,22 +107,16 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, errCannotTrackProviderConfigUsage)
 	}
 
-	if pc.Spec.ClientID.Source != xpv1.CredentialsSourceSecret {
-		return nil, errors.New(errOnlySecretSourceAllowed)
-	}
+	ce := newCredentialsExtractor(c)
 
-	clientID, credsErr := extractCredentialsFromSecret(ctx, c, pc.Spec.ClientID.CommonCredentialSelectors)
+	clientID, credsErr := ce.Extract(ctx, pc.Spec.ClientID, EnvClientID)
 	if credsErr != nil {
-		return nil, errors.Wrap(credsErr, errExtractSecret)
-	}
-
-	if pc.Spec.ClientSecret.Source != xpv1.CredentialsSourceSecret {
-		return nil, errors.New(errOnlySecretSourceAllowed)
+		return nil, errors.Wrap(credsErr, errExtractClientID)
 	}
 
-	clientSecret, credsErr := extractCredentialsFromSecret(ctx, c, pc.Spec.ClientSecret.CommonCredentialSelectors)
+	clientSecret, credsErr := ce.Extract(ctx, pc.Spec.ClientSecret, EnvClientSecret)
 	if credsErr != nil {
-		return nil, errors.Wrap(credsErr, errExtractSecret)
+		return nil, errors.Wrap(credsErr, errExtractClientSecret)
 	}
 
 	basepath := StringValue(pc.Spec.Basepath)
@@ -98,13 +124,76 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		basepath = "/"
 	}
 
-	/* Authenticate */
-	client := &http.Client{}
+	rt, err := transports.Transport(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	rt = &metricsTransport{next: rt}
+	if pc.Spec.Debug {
+		rt = &debugTransport{log: o.logger.WithValues("providerConfig", pc.GetName()), next: rt}
+	}
+	rps, burst := DefaultRequestsPerSecond, DefaultBurst
+	if rl := pc.Spec.RateLimit; rl != nil {
+		if rl.RequestsPerSecond != nil {
+			rps = IntValue(rl.RequestsPerSecond)
+		}
+		if rl.Burst != nil {
+			burst = IntValue(rl.Burst)
+		}
+	}
+	rt = &rateLimitTransport{limiters: limiters, host: pc.Spec.Host, rps: rps, burst: burst, next: rt}
+	rt = newRetryTransport(rt)
+
+	var timeout time.Duration
+	if pc.Spec.Timeout != nil {
+		timeout = pc.Spec.Timeout.Duration
+	}
+	hc := &http.Client{Transport: rt, Timeout: timeout}
+
+	kind := kindOf(mg)
+	token, err := tokens.Token(pc.GetName(), tokenCacheKey(pc, clientID.token, clientSecret.token), func() (*v1alpha1.RespCredentials, error) {
+		sctx := withRequestLabels(ctx, requestLabels{kind: kind, providerConfig: pc.GetName(), operation: operationSignIn})
+		creds, err := signIn(sctx, hc, pc.Spec.Host, clientID.token, clientSecret.token)
+		if err != nil {
+			signIns.WithLabelValues(pc.GetName(), resultFailure).Inc()
+			return nil, err
+		}
+		signIns.WithLabelValues(pc.GetName(), resultSuccess).Inc()
+		return creds, nil
+	})
+	if IsSignInError(err) {
+		o.recorder.Event(pc, event.Warning(reasonSignInFailed, err))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transport := httptransport.NewWithClient(pc.Spec.Host, basepath, zpa.DefaultSchemes, &http.Client{
+		Transport: &invalidatingTransport{
+			name:  pc.GetName(),
+			token: token,
+			cache: tokens,
+			next:  rt,
+		},
+		Timeout: timeout,
+	})
+	transport.DefaultAuthentication = httptransport.BearerToken(token)
+
+	// Requests and responses are logged by the debugTransport, which unlike
+	// the runtime's own debug output redacts credentials.
+	transport.SetDebug(false)
+
+	return &instrumentedRuntime{runtime: transport, kind: kind, providerConfig: pc.GetName()}, nil
+}
+
+// signIn authenticates against the ZPA API and returns the issued bearer
+// token.
+func signIn(ctx context.Context, hc *http.Client, host, clientID, clientSecret string) (*v1alpha1.RespCredentials, error) {
 	data := url.Values{}
-	data.Set("client_id", clientID.token)
-	data.Set("client_secret", clientSecret.token)
+	data.Set("client_id", clientID)
+	data.Set("client_secret", clientSecret)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", "https://"+pc.Spec.Host+"/signin", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://"+host+"/signin", strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -112,17 +201,21 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Content-Length", strconv.Itoa(len(data.Encode())))
 
-	res, err := client.Do(req)
+	res, err := hc.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
+	defer closeBody(res.Body)
+
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	defer closeBody(res.Body)
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, newSignInError(res, body)
+	}
 
 	creds := &v1alpha1.RespCredentials{}
 	err = json.Unmarshal(body, &creds)
@@ -130,36 +223,12 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, err
 	}
 
-	transport := httptransport.New(pc.Spec.Host, basepath, zpa.DefaultSchemes)
-	transport.DefaultAuthentication = httptransport.BearerToken(creds.AccessToken)
-
-	// Enable this line to see request and response in console output
-	transport.SetDebug(true)
-
-	return transport, nil
-}
-
-type providerCredentials struct {
-	token string
-}
-
-func extractCredentialsFromSecret(ctx context.Context, client client.Client, s xpv1.CommonCredentialSelectors) (*providerCredentials, error) {
-	if s.SecretRef == nil {
-		return nil, errors.New(errExtractSecretKey)
-	}
-	secret := &corev1.Secret{}
-	if err := client.Get(ctx, types.NamespacedName{Namespace: s.SecretRef.Namespace, Name: s.SecretRef.Name}, secret); err != nil {
-		return nil, errors.Wrap(err, errGetCredentialsSecret)
-	}
-
-	token := secret.Data[s.SecretRef.Key]
-
-	if token == nil {
-		return nil, errors.New(fmt.Sprintf(errInvalidSecretData, s.SecretRef.Key))
-	}
-
-	creds := &providerCredentials{
-		token: string(token),
+	if creds.AccessToken == "" {
+		return nil, &SignInError{
+			StatusCode: res.StatusCode,
+			Reason:     errNoAccessToken,
+			RequestID:  res.Header.Get(headerRequestID),
+		}
 	}
 
 	return creds, nil
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpa "github.com/haarchri/zpa-go-client/pkg/client"
+	"github.com/haarchri/zpa-go-client/pkg/client/segment_group_controller"
+
+	"github.com/crossplane-contrib/provider-zpa/apis/v1alpha1"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+)
+
+func TestUseProviderConfig(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	customerID := "1"
+	id := srv.Seed(customerID, "segmentGroup", zpafake.Object{"name": "example"})
+
+	kube := func(pc *v1alpha1.ProviderConfig, clientSecret string) client.Client {
+		return &test.MockClient{
+			MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				switch o := obj.(type) {
+				case *v1alpha1.ProviderConfig:
+					pc.DeepCopyInto(o)
+				case *corev1.Secret:
+					o.Data = map[string][]byte{
+						"clientID":     []byte(zpafake.ClientID),
+						"clientSecret": []byte(clientSecret),
+						"ca.crt":       srv.CABundle(),
+					}
+				default:
+					return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				return nil
+			},
+			MockCreate: test.NewMockCreateFn(nil),
+		}
+	}
+	providerConfig := func(name string) *v1alpha1.ProviderConfig {
+		ref := func(key string) *xpv1.SecretKeySelector {
+			return &xpv1.SecretKeySelector{Key: key, SecretReference: xpv1.SecretReference{Name: "zpa", Namespace: "crossplane-system"}}
+		}
+		pc := &v1alpha1.ProviderConfig{Spec: v1alpha1.ProviderConfigSpec{
+			Host: srv.Host(),
+			ClientID: v1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: ref("clientID")},
+			},
+			ClientSecret: v1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: ref("clientSecret")},
+			},
+			TLS: &v1alpha1.TLSConfig{CABundleSecretRef: ref("ca.crt")},
+		}}
+		pc.SetName(name)
+		return pc
+	}
+	managed := func(pc string) *fake.Managed {
+		return &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: pc}}}
+	}
+
+	t.Run("SignInOnce", func(t *testing.T) {
+		pc := providerConfig("sign-in-once")
+		signIns := srv.SignIns
+
+		for i := 0; i < 2; i++ {
+			cfg, err := UseProviderConfig(context.Background(), kube(pc, zpafake.ClientSecret), managed(pc.GetName()))
+			if err != nil {
+				t.Fatalf("UseProviderConfig(...): %v", err)
+			}
+
+			resp, err := zpa.New(cfg, strfmt.Default).SegmentGroupController.GetSegmentGroupUsingGET1(&segment_group_controller.GetSegmentGroupUsingGET1Params{
+				Context:        context.Background(),
+				CustomerID:     customerID,
+				SegmentGroupID: id,
+			})
+			if err != nil {
+				t.Fatalf("GetSegmentGroupUsingGET1(...): %v", err)
+			}
+			if diff := cmp.Diff("example", StringValue(resp.Payload.Name)); diff != "" {
+				t.Errorf("GetSegmentGroupUsingGET1(...): -want, +got:\n%s\n", diff)
+			}
+		}
+
+		if diff := cmp.Diff(1, srv.SignIns-signIns); diff != "" {
+			t.Errorf("UseProviderConfig(...): -want sign-ins, +got sign-ins:\n%s\n", diff)
+		}
+	})
+
+	t.Run("InvalidCredentials", func(t *testing.T) {
+		pc := providerConfig("invalid-credentials")
+
+		mg := managed(pc.GetName())
+		_, err := UseProviderConfig(context.Background(), kube(pc, "wrong"), mg)
+		if !IsSignInError(err) {
+			t.Fatalf("UseProviderConfig(...): want SignInError, got %v", err)
+		}
+		if diff := cmp.Diff("invalid client credentials", err.(*SignInError).Reason); diff != "" {
+			t.Errorf("UseProviderConfig(...): -want reason, +got reason:\n%s\n", diff)
+		}
+		// The managed reconciler reports the error as the Synced condition,
+		// so the Ready condition is left as it was.
+		if diff := cmp.Diff(xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionUnknown}, mg.GetCondition(xpv1.TypeReady)); diff != "" {
+			t.Errorf("UseProviderConfig(...): -want Ready condition, +got:\n%s\n", diff)
+		}
+	})
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/accesspolicyrule/v1alpha1"
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+)
+
+const (
+	customerID  = "72058304855015424"
+	policySetID = "72058304855000001"
+	id          = "72058304855015574"
+	appID       = "72058304855015500"
+)
+
+type accessPolicyRuleModifier func(*v1alpha1.AccessPolicyRule)
+
+func withSpec(p v1alpha1.AccessPolicyRuleParameters) accessPolicyRuleModifier {
+	return func(cr *v1alpha1.AccessPolicyRule) { cr.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.Observation) accessPolicyRuleModifier {
+	return func(cr *v1alpha1.AccessPolicyRule) { cr.Status.AtProvider = o }
+}
+
+func accessPolicyRule(m ...accessPolicyRuleModifier) *v1alpha1.AccessPolicyRule {
+	cr := &v1alpha1.AccessPolicyRule{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.AccessPolicyRuleParameters)) v1alpha1.AccessPolicyRuleParameters {
+	p := v1alpha1.AccessPolicyRuleParameters{
+		CustomerID:  customerID,
+		Action:      "ALLOW",
+		Description: "example",
+		CustomMsg:   "allowed",
+		Operator:    "AND",
+		RuleOrder:   zpaclient.Int32(2),
+		Conditions: []common.PolicyRuleCondition{
+			{
+				Operator: "OR",
+				Operands: []common.PolicyRuleOperand{
+					{ObjectType: common.ObjectTypeApp, RHS: zpaclient.String(appID)},
+				},
+			},
+			{
+				Negated:  true,
+				Operator: "OR",
+				Operands: []common.PolicyRuleOperand{
+					{ObjectType: common.ObjectTypeClientType, RHS: zpaclient.String("zpn_client_type_exporter")},
+					{ObjectType: common.ObjectTypeSAML, LHS: "72058304855015430", RHS: zpaclient.String("admin@example.com"), IdpID: "72058304855015420"},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.PolicyRule)) *models.PolicyRule {
+	p := model()
+	p.ID = id
+	p.PolicySetID = policySetID
+	p.Priority = 2
+	p.RuleOrder = 2
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	// The ZPA API returns the operands of a condition in any order and with
+	// their own IDs.
+	c := p.Conditions[1]
+	c.ID = "72058304855015440"
+	c.Operands[0], c.Operands[1] = c.Operands[1], c.Operands[0]
+	c.Operands[0].ID = "72058304855015441"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	PolicySetID:  policySetID,
+	Priority:     2,
+	RuleOrder:    2,
+}
+
+func TestNewRule(t *testing.T) {
+	type want struct {
+		customerID        string
+		ruleOrder         *int32
+		observedRuleOrder int32
+		err               error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotAccessPolicyRule": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotAccessPolicyRule)},
+		},
+		"Success": {
+			mg:   accessPolicyRule(withSpec(params()), withObservation(observation)),
+			want: want{customerID: customerID, ruleOrder: zpaclient.Int32(2), observedRuleOrder: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := newRule(tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nnewRule(...): -want error, +got error:\n%s\n", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.customerID, r.CustomerID()); diff != "" {
+				t.Errorf("\nr.CustomerID(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.ruleOrder, r.RuleOrder()); diff != "" {
+				t.Errorf("\nr.RuleOrder(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.observedRuleOrder, r.ObservedRuleOrder()); diff != "" {
+				t.Errorf("\nr.ObservedRuleOrder(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	r, err := newRule(accessPolicyRule(withSpec(params())))
+	if err != nil {
+		t.Fatalf("newRule(...): %v", err)
+	}
+
+	got, err := r.Generate(context.Background())
+	if err != nil {
+		t.Fatalf("r.Generate(...): %v", err)
+	}
+	if diff := cmp.Diff(model(), got); diff != "" {
+		t.Errorf("\nr.Generate(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr              resource.Managed
+		upToDate        bool
+		lateInitialized bool
+	}
+
+	observed := accessPolicyRule(withSpec(params()), withObservation(observation))
+	drifted := want{cr: observed}
+
+	cases := map[string]struct {
+		obj  *models.PolicyRule
+		mg   *v1alpha1.AccessPolicyRule
+		want want
+	}{
+		"UpToDate": {
+			obj:  payload(),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: want{cr: observed, upToDate: true},
+		},
+		"LateInitialize": {
+			obj: payload(),
+			mg: accessPolicyRule(withSpec(params(func(p *v1alpha1.AccessPolicyRuleParameters) {
+				p.Operator = ""
+				p.RuleOrder = nil
+			}))),
+			want: want{cr: observed, upToDate: true, lateInitialized: true},
+		},
+		"ActionDrift": {
+			obj:  payload(func(p *models.PolicyRule) { p.Action = "DENY" }),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			obj:  payload(func(p *models.PolicyRule) { p.Description = "changed" }),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: drifted,
+		},
+		"CustomMsgDrift": {
+			obj:  payload(func(p *models.PolicyRule) { p.CustomMsg = "changed" }),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: drifted,
+		},
+		"OperatorDrift": {
+			obj:  payload(func(p *models.PolicyRule) { p.Operator = "OR" }),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: drifted,
+		},
+		"RuleOrderDrift": {
+			obj: payload(func(p *models.PolicyRule) { p.RuleOrder = 1 }),
+			mg:  accessPolicyRule(withSpec(params())),
+			want: want{
+				cr: accessPolicyRule(withSpec(params()), withObservation(func() v1alpha1.Observation {
+					o := observation
+					o.RuleOrder = 1
+					return o
+				}())),
+			},
+		},
+		"ConditionDrift": {
+			obj:  payload(func(p *models.PolicyRule) { p.Conditions[0].Operands[0].RHS = "72058304855015501" }),
+			mg:   accessPolicyRule(withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := newRule(tc.mg)
+			if err != nil {
+				t.Fatalf("newRule(...): %v", err)
+			}
+
+			upToDate, lateInitialized, err := r.Observe(context.Background(), tc.obj)
+			if err != nil {
+				t.Errorf("\nr.Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("\nr.Observe(...): -want up to date, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.lateInitialized, lateInitialized); diff != "" {
+				t.Errorf("\nr.Observe(...): -want late initialized, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, resource.Managed(tc.mg)); diff != "" {
+				t.Errorf("\nr.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// model returns the PolicyRule that is sent to the ZPA API for the parameters
+// returned by params.
+func model() *models.PolicyRule {
+	return &models.PolicyRule{
+		Name:        zpaclient.String("example"),
+		Action:      "ALLOW",
+		Description: "example",
+		CustomMsg:   "allowed",
+		Operator:    "AND",
+		Conditions: []*models.ConditionSet{
+			{
+				Operator: "OR",
+				Operands: []*models.Operand{
+					{ObjectType: "APP", LHS: "id", RHS: appID},
+				},
+			},
+			{
+				Negated:  true,
+				Operator: "OR",
+				Operands: []*models.Operand{
+					{ObjectType: "CLIENT_TYPE", LHS: "id", RHS: "zpn_client_type_exporter"},
+					{ObjectType: "SAML", LHS: "72058304855015430", RHS: "admin@example.com", IdpID: "72058304855015420"},
+				},
+			},
+		},
+	}
+}
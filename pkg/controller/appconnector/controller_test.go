@@ -0,0 +1,532 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconnector
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/appconnector/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/appconnector"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/enrolled"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockac "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/appconnector"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID          = "72058304855015424"
+	id                  = "72058304855015574"
+	appConnectorGroupID = "72058304855015500"
+)
+
+// Errors of the enrolled.External of AppConnectors.
+const (
+	errCreateNotSupported = "App Connectors enroll using a provisioning key and cannot be created, set the external name annotation to the ID of an existing App Connector to adopt it"
+	errUpdateFailed       = "cannot update AppConnector"
+	errDescribeFailed     = "cannot describe AppConnector"
+	errDeleteFailed       = "cannot delete AppConnector"
+	errNoExternalName     = "AppConnector has no external name"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type appConnectorModifier func(*v1alpha1.AppConnector)
+
+func withExternalName(n string) appConnectorModifier {
+	return func(cr *v1alpha1.AppConnector) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.AppConnectorParameters) appConnectorModifier {
+	return func(cr *v1alpha1.AppConnector) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) appConnectorModifier {
+	return func(cr *v1alpha1.AppConnector) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) appConnectorModifier {
+	return func(cr *v1alpha1.AppConnector) { cr.Status.AtProvider = o }
+}
+
+func appConnector(m ...appConnectorModifier) *v1alpha1.AppConnector {
+	cr := &v1alpha1.AppConnector{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.AppConnectorParameters)) v1alpha1.AppConnectorParameters {
+	p := v1alpha1.AppConnectorParameters{
+		CustomerID:          customerID,
+		Enabled:             zpaclient.Bool(true),
+		Description:         "example",
+		AppConnectorGroupID: zpaclient.String(appConnectorGroupID),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.Connector)) *models.Connector {
+	p := &models.Connector{
+		ID:                    id,
+		Name:                  zpaclient.String("connector-1"),
+		Enabled:               true,
+		Description:           "example",
+		AppConnectorGroupID:   appConnectorGroupID,
+		AppConnectorGroupName: "example",
+		ControlChannelStatus:  "ZPN_STATUS_AUTHENTICATED",
+		CurrentVersion:        "21.49.1",
+		LastBrokerConnectTime: "1633046400000000",
+		PrivateIP:             "10.0.0.10",
+		CreationTime:          "1633046400",
+		ModifiedBy:            "admin",
+		ModifiedTime:          "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                    id,
+	CreationTime:          "1633046400",
+	ModifiedBy:            "admin",
+	ModifiedTime:          "1633050000",
+	AppConnectorGroupName: "example",
+	ControlChannelStatus:  "ZPN_STATUS_AUTHENTICATED",
+	CurrentVersion:        "21.49.1",
+	LastBrokerConnectTime: "1633046400000000",
+	PrivateIP:             "10.0.0.10",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppConnector": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: zpafake.ProviderConfigName}}},
+			want: want{called: true, err: errors.New(errNotAppConnector)},
+		},
+		"NoProviderConfigRef": {
+			mg:   appConnector(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := appConnector()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &enrolled.Connector{
+				Kube:  srv.Kube(),
+				Kind:  v1alpha1.AppConnectorKind,
+				Title: "App Connector",
+				NewComponentFn: func(transport runtime.ClientTransport) enrolled.ComponentFn {
+					called = true
+					return componentFn(appconnector.New(transport, strfmt.Default))
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want NewComponentFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*models.Connector)) func(m *mockac.MockClientService) {
+		return func(m *mockac.MockClientService) {
+			m.EXPECT().GetAppConnector(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := appConnector(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockac.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppConnector": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotAppConnector)},
+		},
+		"NoExternalName": {
+			mg:   appConnector(withSpec(params())),
+			want: want{cr: appConnector(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().GetAppConnector(getParams()).Return(nil, errNotFound)
+			},
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: want{cr: appConnector(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().GetAppConnector(getParams()).Return(nil, errBoom)
+			},
+			mg: appConnector(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  appConnector(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*models.Connector) {}),
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Disconnected": {
+			mock: drift(func(p *models.Connector) { p.ControlChannelStatus = enrolled.ControlChannelDisconnected }),
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: want{
+				cr: appConnector(withExternalName(id), withSpec(params()), withConditions(xpv1.Unavailable()), withObservation(func() v1alpha1.Observation {
+					o := observation
+					o.ControlChannelStatus = enrolled.ControlChannelDisconnected
+					return o
+				}())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			mock: drift(func(*models.Connector) {}),
+			mg: appConnector(withExternalName(id), withSpec(params(func(p *v1alpha1.AppConnectorParameters) {
+				p.Enabled = nil
+				p.Description = ""
+				p.AppConnectorGroupID = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *models.Connector) { p.Enabled = false }),
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *models.Connector) { p.Description = "changed" }),
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"AppConnectorGroupIDDrift": {
+			mock: drift(func(p *models.Connector) { p.AppConnectorGroupID = "72058304855015501" }),
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.AppConnectorKind, Title: "App Connector", Component: componentFn(m)}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppConnector": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAppConnector),
+		},
+		"NotSupported": {
+			mg:   appConnector(withSpec(params())),
+			want: errors.New(errCreateNotSupported),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &enrolled.External{Kind: v1alpha1.AppConnectorKind, Title: "App Connector", Component: componentFn(nil)}
+
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockac.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppConnector": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAppConnector),
+		},
+		"Success": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().GetAppConnector(getParams()).Return(payload(func(p *models.Connector) {
+					p.Enabled = false
+					p.AppConnectorGroupID = "72058304855015501"
+				}), nil)
+				m.EXPECT().UpdateAppConnector(&appconnector.UpdateAppConnectorParams{
+					Context:     context.Background(),
+					CustomerID:  customerID,
+					ConnectorID: id,
+					Connector:   payload(),
+				}).Return(nil)
+			},
+			mg: appConnector(withExternalName(id), withSpec(params())),
+		},
+		"GetFailed": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().GetAppConnector(getParams()).Return(nil, errBoom)
+			},
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().GetAppConnector(getParams()).Return(payload(), nil)
+				m.EXPECT().UpdateAppConnector(gomock.Any()).Return(errBoom)
+			},
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.AppConnectorKind, Title: "App Connector", Component: componentFn(m)}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockac.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppConnector": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAppConnector),
+		},
+		"NoExternalName": {
+			mg:   appConnector(withSpec(params())),
+			want: errors.New(errNoExternalName),
+		},
+		"Success": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().DeleteAppConnector(&appconnector.DeleteAppConnectorParams{
+					Context:     context.Background(),
+					CustomerID:  customerID,
+					ConnectorID: id,
+				}).Return(nil)
+			},
+			mg: appConnector(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().DeleteAppConnector(gomock.Any()).Return(errNotFound)
+			},
+			mg: appConnector(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockac.MockClientService) {
+				m.EXPECT().DeleteAppConnector(gomock.Any()).Return(errBoom)
+			},
+			mg:   appConnector(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.AppConnectorKind, Title: "App Connector", Component: componentFn(m)}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *appconnector.GetAppConnectorParams {
+	return &appconnector.GetAppConnectorParams{
+		Context:     context.Background(),
+		CustomerID:  customerID,
+		ConnectorID: id,
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &enrolled.External{Kind: v1alpha1.AppConnectorKind, Title: "App Connector", Component: componentFn(appconnector.New(srv.Transport(), strfmt.Default))}
+
+	groupID := srv.Seed(customerID, "appConnectorGroup", zpafake.Object{"name": "example"})
+	otherGroupID := srv.Seed(customerID, "appConnectorGroup", zpafake.Object{"name": "other"})
+	externalName := srv.Seed(customerID, "connector", zpafake.Object{
+		"name":                 "connector-1",
+		"enabled":              true,
+		"appConnectorGroupId":  groupID,
+		"controlChannelStatus": "ZPN_STATUS_AUTHENTICATED",
+		"currentVersion":       "21.49.1",
+		"privateIp":            "10.0.0.10",
+	})
+
+	cr := &v1alpha1.AppConnector{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.AppConnectorParameters{CustomerID: customerID}
+
+	if _, err := e.Create(ctx, cr); err == nil {
+		t.Errorf("e.Create(...): want error, got nil")
+	}
+
+	meta.SetExternalName(cr, externalName)
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpaclient.String(groupID), cr.Spec.ForProvider.AppConnectorGroupID); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized appConnectorGroupID, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("10.0.0.10", cr.Status.AtProvider.PrivateIP); diff != "" {
+		t.Errorf("e.Observe(...): -want observed private IP, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Enabled = zpaclient.Bool(false)
+	cr.Spec.ForProvider.AppConnectorGroupID = zpaclient.String(otherGroupID)
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want app connector to be up to date after update, got %+v, %v", obs, err)
+	}
+	got, _ := srv.Get(customerID, "connector", externalName)
+	if got["appConnectorGroupId"] != otherGroupID || got["name"] != "connector-1" {
+		t.Errorf("e.Update(...): want app connector to be moved and keep its name, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,602 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appconnectorgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/appconnectorgroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/appconnectorgroup"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockacg "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/appconnectorgroup"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type appConnectorGroupModifier func(*v1alpha1.AppConnectorGroup)
+
+func withExternalName(n string) appConnectorGroupModifier {
+	return func(cr *v1alpha1.AppConnectorGroup) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.AppConnectorGroupParameters) appConnectorGroupModifier {
+	return func(cr *v1alpha1.AppConnectorGroup) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) appConnectorGroupModifier {
+	return func(cr *v1alpha1.AppConnectorGroup) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) appConnectorGroupModifier {
+	return func(cr *v1alpha1.AppConnectorGroup) { cr.Status.AtProvider = o }
+}
+
+func appConnectorGroup(m ...appConnectorGroupModifier) *v1alpha1.AppConnectorGroup {
+	cr := &v1alpha1.AppConnectorGroup{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.AppConnectorGroupParameters)) v1alpha1.AppConnectorGroupParameters {
+	p := v1alpha1.AppConnectorGroupParameters{
+		CustomerID:             customerID,
+		Enabled:                zpaclient.Bool(true),
+		Description:            "example",
+		CityCountry:            "San Jose, US",
+		CountryCode:            "US",
+		Location:               "San Jose, CA, USA",
+		Latitude:               "37.3382082",
+		Longitude:              "-121.8863286",
+		VersionProfileID:       "0",
+		OverrideVersionProfile: zpaclient.Bool(true),
+		UpgradeDay:             "SUNDAY",
+		UpgradeTimeInSecs:      "66600",
+		DNSQueryType:           "IPV4_IPV6",
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.AppConnectorGroup)) *models.AppConnectorGroup {
+	p := model()
+	p.ID = id
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	p.VersionProfileName = "Default"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                 id,
+	CreationTime:       "1633046400",
+	ModifiedBy:         "admin",
+	ModifiedTime:       "1633050000",
+	VersionProfileName: "Default",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppConnectorGroup": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotAppConnectorGroup)},
+		},
+		"NoProviderConfigRef": {
+			mg:   appConnectorGroup(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := appConnectorGroup()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) appconnectorgroup.ClientService {
+					called = true
+					return appconnectorgroup.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*models.AppConnectorGroup)) func(m *mockacg.MockClientService) {
+		return func(m *mockacg.MockClientService) {
+			m.EXPECT().GetAppConnectorGroup(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := appConnectorGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockacg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppConnectorGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotAppConnectorGroup)},
+		},
+		"NoExternalName": {
+			mg:   appConnectorGroup(withSpec(params())),
+			want: want{cr: appConnectorGroup(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().GetAppConnectorGroup(getParams()).Return(nil, errNotFound)
+			},
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: appConnectorGroup(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().GetAppConnectorGroup(getParams()).Return(nil, errBoom)
+			},
+			mg: appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  appConnectorGroup(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*models.AppConnectorGroup) {}),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*models.AppConnectorGroup) {}),
+			mg: appConnectorGroup(withExternalName(id), withSpec(params(func(p *v1alpha1.AppConnectorGroupParameters) {
+				p.Enabled = nil
+				p.OverrideVersionProfile = nil
+				p.CityCountry = ""
+				p.CountryCode = ""
+				p.VersionProfileID = ""
+				p.UpgradeDay = ""
+				p.UpgradeTimeInSecs = ""
+				p.DNSQueryType = ""
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.Enabled = false }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"OverrideVersionProfileDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.OverrideVersionProfile = false }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.Description = "changed" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CityCountryDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.CityCountry = "Frankfurt, DE" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CountryCodeDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.CountryCode = "DE" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LocationDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.Location = "Frankfurt, Germany" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LatitudeDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.Latitude = "50.1109221" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LongitudeDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.Longitude = "8.6821267" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"VersionProfileIDDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.VersionProfileID = "2" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UpgradeDayDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.UpgradeDay = "MONDAY" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UpgradeTimeInSecsDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.UpgradeTimeInSecs = "0" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DNSQueryTypeDrift": {
+			mock: drift(func(p *models.AppConnectorGroup) { p.DNSQueryType = "IPV4" }),
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockacg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockacg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotAppConnectorGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotAppConnectorGroup)},
+		},
+		"Success": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().AddAppConnectorGroup(&appconnectorgroup.AddAppConnectorGroupParams{
+					Context:           context.Background(),
+					CustomerID:        customerID,
+					AppConnectorGroup: model(),
+				}).Return(&models.AppConnectorGroup{ID: id}, nil)
+			},
+			mg: appConnectorGroup(withSpec(params())),
+			want: want{
+				cr:  appConnectorGroup(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().AddAppConnectorGroup(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: appConnectorGroup(withSpec(params())),
+			want: want{
+				cr:  appConnectorGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockacg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockacg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppConnectorGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAppConnectorGroup),
+		},
+		"Success": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().UpdateAppConnectorGroup(&appconnectorgroup.UpdateAppConnectorGroupParams{
+					Context:             context.Background(),
+					CustomerID:          customerID,
+					AppConnectorGroupID: id,
+					AppConnectorGroup:   model(),
+				}).Return(nil)
+			},
+			mg: appConnectorGroup(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().UpdateAppConnectorGroup(gomock.Any()).Return(errBoom)
+			},
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockacg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockacg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotAppConnectorGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAppConnectorGroup),
+		},
+		"NoExternalName": {
+			mg:   appConnectorGroup(withSpec(params())),
+			want: errors.New(errNotAppConnectorGroup),
+		},
+		"Success": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().DeleteAppConnectorGroup(&appconnectorgroup.DeleteAppConnectorGroupParams{
+					Context:             context.Background(),
+					CustomerID:          customerID,
+					AppConnectorGroupID: id,
+				}).Return(nil)
+			},
+			mg: appConnectorGroup(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().DeleteAppConnectorGroup(gomock.Any()).Return(errNotFound)
+			},
+			mg: appConnectorGroup(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockacg.MockClientService) {
+				m.EXPECT().DeleteAppConnectorGroup(gomock.Any()).Return(errBoom)
+			},
+			mg:   appConnectorGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockacg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *appconnectorgroup.GetAppConnectorGroupParams {
+	return &appconnectorgroup.GetAppConnectorGroupParams{
+		Context:             context.Background(),
+		CustomerID:          customerID,
+		AppConnectorGroupID: id,
+	}
+}
+
+// model returns the AppConnectorGroup that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *models.AppConnectorGroup {
+	return &models.AppConnectorGroup{
+		Name:                   zpaclient.String("example"),
+		Enabled:                true,
+		Description:            "example",
+		CityCountry:            "San Jose, US",
+		CountryCode:            "US",
+		Location:               "San Jose, CA, USA",
+		Latitude:               "37.3382082",
+		Longitude:              "-121.8863286",
+		VersionProfileID:       "0",
+		OverrideVersionProfile: true,
+		UpgradeDay:             "SUNDAY",
+		UpgradeTimeInSecs:      "66600",
+		DNSQueryType:           "IPV4_IPV6",
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: appconnectorgroup.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.AppConnectorGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.AppConnectorGroupParameters{
+		CustomerID:  customerID,
+		Description: "example app connector group",
+		Enabled:     zpaclient.Bool(true),
+		Location:    "San Jose, CA, USA",
+		Latitude:    "37.3382082",
+		Longitude:   "-121.8863286",
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "appConnectorGroup", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want app connector group %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpaclient.Bool(false), cr.Spec.ForProvider.OverrideVersionProfile); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized overrideVersionProfile, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.UpgradeDay = "SUNDAY"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want app connector group to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "appConnectorGroup", externalName); got["upgradeDay"] != "SUNDAY" {
+		t.Errorf("e.Update(...): want upgradeDay to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
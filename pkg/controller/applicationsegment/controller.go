@@ -53,6 +53,8 @@ const (
 // SetupApplicationSegment adds a controller that reconciles ApplicationSegments.
 func SetupApplicationSegment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1alpha1.ApplicationSegmentGroupKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -62,16 +64,18 @@ func SetupApplicationSegment(mgr ctrl.Manager, l logging.Logger, rl workqueue.Ra
 		For(&v1alpha1.ApplicationSegment{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ApplicationSegmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: zpa.New}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: zpa.New, logger: logger, recorder: recorder}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal
+	logger      logging.Logger
+	recorder    event.Recorder
 }
 
 type external struct {
@@ -85,7 +89,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotApplicationSegment)
 	}
 
-	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg)
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +164,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			SegmentGroupID:       zpaclient.StringValue(cr.Spec.ForProvider.SegmentGroupID),
 			TCPPortRanges:        cr.Spec.ForProvider.TCPPortRanges,
 			UDPPortRanges:        cr.Spec.ForProvider.UDPPortRanges,
+			ClientlessApps:       generateClientlessApps(cr.Spec.ForProvider.ClientlessApps, nil),
 		},
 	}
 
@@ -204,6 +209,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			SegmentGroupID:       zpaclient.StringValue(cr.Spec.ForProvider.SegmentGroupID),
 			TCPPortRanges:        cr.Spec.ForProvider.TCPPortRanges,
 			UDPPortRanges:        cr.Spec.ForProvider.UDPPortRanges,
+			ClientlessApps:       generateClientlessApps(cr.Spec.ForProvider.ClientlessApps, cr.Status.AtProvider.ClientlessApps),
 		},
 	}
 
@@ -284,6 +290,95 @@ func (e *external) LateInitialize(cr *v1alpha1.ApplicationSegment, obj *applicat
 		cr.Spec.ForProvider.HealthReporting = obj.Payload.HealthReporting
 	}
 
+	// Browser access applications that were configured in the ZPA admin portal
+	// are adopted rather than removed by the next update.
+	if cr.Spec.ForProvider.ClientlessApps == nil {
+		cr.Spec.ForProvider.ClientlessApps = generateClientlessAppParameters(obj.Payload.ClientlessApps)
+	}
+
+	for i := range cr.Spec.ForProvider.ClientlessApps {
+		app := &cr.Spec.ForProvider.ClientlessApps[i]
+		o := clientlessAppByDomain(obj.Payload.ClientlessApps, app.Domain)
+		if o == nil {
+			continue
+		}
+
+		if app.Enabled == nil {
+			app.Enabled = zpaclient.Bool(o.Enabled)
+		}
+
+		if app.AllowOptions == nil {
+			app.AllowOptions = zpaclient.Bool(o.AllowOptions)
+		}
+
+		if app.TrustUntrustedCert == nil {
+			app.TrustUntrustedCert = zpaclient.Bool(o.TrustUntrustedCert)
+		}
+	}
+
+}
+
+// generateClientlessApps generates the browser access applications that are
+// sent to the ZPA API. The IDs of observed applications are kept, matched by
+// their domain, so that they are updated rather than replaced. No
+// applications are sent as an empty list rather than null.
+func generateClientlessApps(in []v1alpha1.ClientlessApp, observed []v1alpha1.ClientlessAppObservation) []*models.BAAppDto {
+
+	out := make([]*models.BAAppDto, len(in))
+	for i, app := range in {
+		out[i] = &models.BAAppDto{
+			Name:                app.Name,
+			Description:         app.Description,
+			Enabled:             zpaclient.BoolValue(app.Enabled),
+			Domain:              app.Domain,
+			ApplicationPort:     app.ApplicationPort,
+			ApplicationProtocol: app.ApplicationProtocol,
+			CertificateID:       zpaclient.StringValue(app.CertificateID),
+			AllowOptions:        zpaclient.BoolValue(app.AllowOptions),
+			TrustUntrustedCert:  zpaclient.BoolValue(app.TrustUntrustedCert),
+		}
+		for _, o := range observed {
+			if o.Domain == app.Domain {
+				out[i].ID = o.ID
+				out[i].AppID = o.AppID
+			}
+		}
+	}
+	return out
+}
+
+// generateClientlessAppParameters generates the parameters of the supplied
+// browser access applications, or nil if there are none.
+func generateClientlessAppParameters(apps []*models.BAAppDto) []v1alpha1.ClientlessApp {
+	var out []v1alpha1.ClientlessApp
+	for _, app := range apps {
+		if app == nil {
+			continue
+		}
+		out = append(out, v1alpha1.ClientlessApp{
+			Name:                app.Name,
+			Description:         app.Description,
+			Enabled:             zpaclient.Bool(app.Enabled),
+			Domain:              app.Domain,
+			ApplicationPort:     app.ApplicationPort,
+			ApplicationProtocol: app.ApplicationProtocol,
+			CertificateID:       zpaclient.StringToPtr(app.CertificateID),
+			AllowOptions:        zpaclient.Bool(app.AllowOptions),
+			TrustUntrustedCert:  zpaclient.Bool(app.TrustUntrustedCert),
+		})
+	}
+	return out
+}
+
+// clientlessAppByDomain returns the browser access application of the
+// supplied domain, or nil if there is none.
+func clientlessAppByDomain(apps []*models.BAAppDto, domain string) *models.BAAppDto {
+	for _, app := range apps {
+		if app != nil && app.Domain == domain {
+			return app
+		}
+	}
+	return nil
 }
 
 // generateObservation generates observation for the input object application_controller.GetApplicationUsingGET1OK
@@ -297,6 +392,19 @@ func generateObservation(in *application_controller.GetApplicationUsingGET1OK) v
 	cr.ModifiedBy = obj.ModifiedBy
 	cr.ModifiedTime = obj.ModifiedTime
 
+	for _, app := range obj.ClientlessApps {
+		if app == nil {
+			continue
+		}
+		cr.ClientlessApps = append(cr.ClientlessApps, v1alpha1.ClientlessAppObservation{
+			ID:              app.ID,
+			AppID:           app.AppID,
+			Domain:          app.Domain,
+			Cname:           app.Cname,
+			CertificateName: app.CertificateName,
+		})
+	}
+
 	return cr
 }
 
@@ -368,5 +476,40 @@ func isUpToDate(cr *v1alpha1.ApplicationSegmentParameters, gobj *application_con
 		return false
 	}
 
+	return isClientlessAppsUpToDate(cr.ClientlessApps, obj.ClientlessApps)
+}
+
+// isClientlessAppsUpToDate checks whether there is a change in any of the
+// browser access applications, which are matched by their domain.
+func isClientlessAppsUpToDate(in []v1alpha1.ClientlessApp, apps []*models.BAAppDto) bool {
+	if len(in) != len(apps) {
+		return false
+	}
+
+	for _, app := range in {
+		o := clientlessAppByDomain(apps, app.Domain)
+		if o == nil {
+			return false
+		}
+
+		if !zpaclient.IsEqualBool(app.Enabled, zpaclient.Bool(o.Enabled)) ||
+			!zpaclient.IsEqualBool(app.AllowOptions, zpaclient.Bool(o.AllowOptions)) ||
+			!zpaclient.IsEqualBool(app.TrustUntrustedCert, zpaclient.Bool(o.TrustUntrustedCert)) {
+			return false
+		}
+
+		for _, f := range []struct{ want, got string }{
+			{app.Name, o.Name},
+			{app.Description, o.Description},
+			{app.ApplicationPort, o.ApplicationPort},
+			{app.ApplicationProtocol, o.ApplicationProtocol},
+			{zpaclient.StringValue(app.CertificateID), o.CertificateID},
+		} {
+			if f.want != f.got {
+				return false
+			}
+		}
+	}
+
 	return true
 }
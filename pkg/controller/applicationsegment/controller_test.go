@@ -0,0 +1,782 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpa "github.com/haarchri/zpa-go-client/pkg/client"
+	"github.com/haarchri/zpa-go-client/pkg/client/application_controller"
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/applicationsegment/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockapp "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/application_controller"
+)
+
+const (
+	customerID     = "72058304855015424"
+	id             = "72058304855015574"
+	segmentGroupID = "72058304855015500"
+
+	certificateID   = "72058304855015600"
+	clientlessAppID = "72058304855015601"
+)
+
+var errBoom = errors.New("boom")
+
+type applicationSegmentModifier func(*v1alpha1.ApplicationSegment)
+
+func withExternalName(n string) applicationSegmentModifier {
+	return func(cr *v1alpha1.ApplicationSegment) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ApplicationSegmentParameters) applicationSegmentModifier {
+	return func(cr *v1alpha1.ApplicationSegment) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) applicationSegmentModifier {
+	return func(cr *v1alpha1.ApplicationSegment) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) applicationSegmentModifier {
+	return func(cr *v1alpha1.ApplicationSegment) { cr.Status.AtProvider = o }
+}
+
+func applicationSegment(m ...applicationSegmentModifier) *v1alpha1.ApplicationSegment {
+	cr := &v1alpha1.ApplicationSegment{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ApplicationSegmentParameters)) v1alpha1.ApplicationSegmentParameters {
+	p := v1alpha1.ApplicationSegmentParameters{
+		CustomerID:           customerID,
+		BypassType:           "NEVER",
+		ConfigSpace:          "DEFAULT",
+		DefaultIdleTimeout:   "3600",
+		DefaultMaxAge:        "86400",
+		Description:          "example",
+		DomainNames:          []string{"example.com"},
+		DoubleEncrypt:        zpaclient.Bool(false),
+		Enabled:              zpaclient.Bool(true),
+		HealthCheckType:      "DEFAULT",
+		HealthReporting:      "ON_ACCESS",
+		IcmpAccessType:       "NONE",
+		IPAnchored:           zpaclient.Bool(false),
+		IsCnameEnabled:       zpaclient.Bool(true),
+		PassiveHealthEnabled: zpaclient.Bool(true),
+		SegmentGroupID:       zpaclient.String(segmentGroupID),
+		TCPPortRanges:        []string{"443", "443"},
+		UDPPortRanges:        []string{"53", "53"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+// withClientlessApp adds a browser access application to the parameters.
+func withClientlessApp(p *v1alpha1.ApplicationSegmentParameters) {
+	p.ClientlessApps = []v1alpha1.ClientlessApp{{
+		Name:                "example",
+		Domain:              "example.com",
+		ApplicationPort:     "443",
+		ApplicationProtocol: "HTTPS",
+		CertificateID:       zpaclient.String(certificateID),
+		Enabled:             zpaclient.Bool(true),
+		AllowOptions:        zpaclient.Bool(false),
+		TrustUntrustedCert:  zpaclient.Bool(true),
+	}}
+}
+
+// clientlessApp returns the browser access application that is sent to the
+// ZPA API for withClientlessApp.
+func clientlessApp() *models.BAAppDto {
+	return &models.BAAppDto{
+		Name:                "example",
+		Domain:              "example.com",
+		ApplicationPort:     "443",
+		ApplicationProtocol: "HTTPS",
+		CertificateID:       certificateID,
+		Enabled:             true,
+		TrustUntrustedCert:  true,
+	}
+}
+
+// observedClientlessApp returns the browser access application that the ZPA
+// API returns for withClientlessApp.
+func observedClientlessApp(m ...func(*models.BAAppDto)) func(*models.ApplicationResource) {
+	return func(p *models.ApplicationResource) {
+		a := clientlessApp()
+		a.ID = clientlessAppID
+		a.AppID = id
+		a.Cname = "example.com.b.zscaler.net"
+		a.CertificateName = "example"
+		for _, f := range m {
+			f(a)
+		}
+		p.ClientlessApps = []*models.BAAppDto{a}
+	}
+}
+
+func payload(m ...func(*models.ApplicationResource)) *application_controller.GetApplicationUsingGET1OK {
+	p := model()
+	p.ID = id
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	for _, f := range m {
+		f(p)
+	}
+	return &application_controller.GetApplicationUsingGET1OK{Payload: p}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+}
+
+var clientlessObservation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	ClientlessApps: []v1alpha1.ClientlessAppObservation{{
+		ID:              clientlessAppID,
+		AppID:           id,
+		Domain:          "example.com",
+		Cname:           "example.com.b.zscaler.net",
+		CertificateName: "example",
+	}},
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotApplicationSegment": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotApplicationSegment)},
+		},
+		"NoProviderConfigRef": {
+			mg:   applicationSegment(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := applicationSegment()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal {
+					called = true
+					return zpa.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*models.ApplicationResource)) func(m *mockapp.MockClientService) {
+		return func(m *mockapp.MockClientService) {
+			m.EXPECT().GetApplicationUsingGET1(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := applicationSegment(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+	observedClientless := applicationSegment(withExternalName(id), withSpec(params(withClientlessApp)), withConditions(xpv1.Available()), withObservation(clientlessObservation))
+	driftedClientless := want{cr: observedClientless, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockapp.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotApplicationSegment": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotApplicationSegment)},
+		},
+		"NoExternalName": {
+			mg:   applicationSegment(withSpec(params())),
+			want: want{cr: applicationSegment(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().GetApplicationUsingGET1(getParams()).Return(nil, &application_controller.GetApplicationUsingGET1BadRequest{})
+			},
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: want{cr: applicationSegment(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().GetApplicationUsingGET1(getParams()).Return(nil, errBoom)
+			},
+			mg: applicationSegment(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  applicationSegment(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*models.ApplicationResource) {}),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*models.ApplicationResource) {}),
+			mg: applicationSegment(withExternalName(id), withSpec(params(func(p *v1alpha1.ApplicationSegmentParameters) {
+				p.BypassType = ""
+				p.ConfigSpace = ""
+				p.DoubleEncrypt = nil
+				p.Enabled = nil
+				p.HealthCheckType = ""
+				p.HealthReporting = ""
+				p.IcmpAccessType = ""
+				p.IPAnchored = nil
+				p.IsCnameEnabled = nil
+				p.PassiveHealthEnabled = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"BypassTypeDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.BypassType = "ALWAYS" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ConfigSpaceDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.ConfigSpace = "SIEM" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DefaultIdleTimeoutDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.DefaultIdleTimeout = "60" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DefaultMaxAgeDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.DefaultMaxAge = "60" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.Description = "changed" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DomainNamesDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.DomainNames = []string{"example.org"} }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DoubleEncryptDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.DoubleEncrypt = true }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.Enabled = false }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"HealthCheckTypeDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.HealthCheckType = "NONE" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"HealthReportingDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.HealthReporting = "CONTINUOUS" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"IcmpAccessTypeDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.IcmpAccessType = "PING" }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"IPAnchoredDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.IPAnchored = true }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"IsCnameEnabledDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.IsCnameEnabled = false }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"PassiveHealthEnabledDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.PassiveHealthEnabled = false }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"TCPPortRangesDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.TCPPortRanges = []string{"80", "80"} }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UDPPortRangesDrift": {
+			mock: drift(func(p *models.ApplicationResource) { p.UDPPortRanges = nil }),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ClientlessAppUpToDate": {
+			mock: drift(observedClientlessApp()),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: want{cr: observedClientless, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ClientlessAppLateInitialize": {
+			mock: drift(observedClientlessApp()),
+			mg: applicationSegment(withExternalName(id), withSpec(params(withClientlessApp, func(p *v1alpha1.ApplicationSegmentParameters) {
+				p.ClientlessApps[0].Enabled = nil
+				p.ClientlessApps[0].AllowOptions = nil
+				p.ClientlessApps[0].TrustUntrustedCert = nil
+			}))),
+			want: want{cr: observedClientless, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"ClientlessAppsLateInitialize": {
+			mock: drift(observedClientlessApp()),
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: want{cr: observedClientless, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"ClientlessAppMissing": {
+			mock: drift(func(*models.ApplicationResource) {}),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: want{
+				cr:  applicationSegment(withExternalName(id), withSpec(params(withClientlessApp)), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ClientlessAppDomainDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.Domain = "example.org" })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: want{
+				cr: applicationSegment(withExternalName(id), withSpec(params(withClientlessApp)), withConditions(xpv1.Available()), withObservation(func() v1alpha1.Observation {
+					o := *clientlessObservation.DeepCopy()
+					o.ClientlessApps[0].Domain = "example.org"
+					return o
+				}())),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ClientlessAppPortDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.ApplicationPort = "8443" })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: driftedClientless,
+		},
+		"ClientlessAppProtocolDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.ApplicationProtocol = "HTTP" })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: driftedClientless,
+		},
+		"ClientlessAppCertificateDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.CertificateID = "72058304855015602" })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: driftedClientless,
+		},
+		"ClientlessAppAllowOptionsDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.AllowOptions = true })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: driftedClientless,
+		},
+		"ClientlessAppTrustUntrustedCertDrift": {
+			mock: drift(observedClientlessApp(func(a *models.BAAppDto) { a.TrustUntrustedCert = false })),
+			mg:   applicationSegment(withExternalName(id), withSpec(params(withClientlessApp))),
+			want: driftedClientless,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockapp.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{ApplicationController: m}}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockapp.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotApplicationSegment": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotApplicationSegment)},
+		},
+		"Success": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().AddApplicationUsingPOST1(&application_controller.AddApplicationUsingPOST1Params{
+					Context:     context.Background(),
+					CustomerID:  customerID,
+					Application: model(),
+				}).Return(&application_controller.AddApplicationUsingPOST1Created{Payload: &models.ApplicationResource{ID: id}}, nil)
+			},
+			mg: applicationSegment(withSpec(params())),
+			want: want{
+				cr:  applicationSegment(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"SuccessWithClientlessApp": {
+			mock: func(m *mockapp.MockClientService) {
+				app := model()
+				app.ClientlessApps = []*models.BAAppDto{clientlessApp()}
+				m.EXPECT().AddApplicationUsingPOST1(&application_controller.AddApplicationUsingPOST1Params{
+					Context:     context.Background(),
+					CustomerID:  customerID,
+					Application: app,
+				}).Return(&application_controller.AddApplicationUsingPOST1Created{Payload: &models.ApplicationResource{ID: id}}, nil)
+			},
+			mg: applicationSegment(withSpec(params(withClientlessApp))),
+			want: want{
+				cr:  applicationSegment(withSpec(params(withClientlessApp)), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().AddApplicationUsingPOST1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: applicationSegment(withSpec(params())),
+			want: want{
+				cr:  applicationSegment(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockapp.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{ApplicationController: m}}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockapp.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotApplicationSegment": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotApplicationSegment),
+		},
+		"Success": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().UpdateApplicationV2UsingPUT1(&application_controller.UpdateApplicationV2UsingPUT1Params{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					ApplicationID: id,
+					Application:   model(),
+				}).Return(nil, &application_controller.UpdateApplicationV2UsingPUT1NoContent{}, nil)
+			},
+			mg: applicationSegment(withExternalName(id), withSpec(params())),
+		},
+		"SuccessWithClientlessApp": {
+			mock: func(m *mockapp.MockClientService) {
+				app := model()
+				observedClientlessApp(func(a *models.BAAppDto) {
+					a.Cname = ""
+					a.CertificateName = ""
+				})(app)
+				m.EXPECT().UpdateApplicationV2UsingPUT1(&application_controller.UpdateApplicationV2UsingPUT1Params{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					ApplicationID: id,
+					Application:   app,
+				}).Return(nil, &application_controller.UpdateApplicationV2UsingPUT1NoContent{}, nil)
+			},
+			mg: applicationSegment(withExternalName(id), withSpec(params(withClientlessApp)), withObservation(clientlessObservation)),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().UpdateApplicationV2UsingPUT1(gomock.Any()).Return(nil, nil, errBoom)
+			},
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockapp.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{ApplicationController: m}}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockapp.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotApplicationSegment": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotApplicationSegment),
+		},
+		"NoExternalName": {
+			mg:   applicationSegment(withSpec(params())),
+			want: errors.New(errNotApplicationSegment),
+		},
+		"Success": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().DeleteApplicationUsingDELETE1(&application_controller.DeleteApplicationUsingDELETE1Params{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					ApplicationID: id,
+					ForceDelete:   zpaclient.Bool(true),
+				}).Return(&application_controller.DeleteApplicationUsingDELETE1NoContent{}, nil)
+			},
+			mg: applicationSegment(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockapp.MockClientService) {
+				m.EXPECT().DeleteApplicationUsingDELETE1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg:   applicationSegment(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockapp.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{ApplicationController: m}}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *application_controller.GetApplicationUsingGET1Params {
+	return &application_controller.GetApplicationUsingGET1Params{
+		Context:       context.Background(),
+		CustomerID:    customerID,
+		ApplicationID: id,
+	}
+}
+
+// model returns the ApplicationResource that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *models.ApplicationResource {
+	return &models.ApplicationResource{
+		Name:                 "example",
+		BypassType:           "NEVER",
+		ConfigSpace:          "DEFAULT",
+		DefaultIdleTimeout:   "3600",
+		DefaultMaxAge:        "86400",
+		Description:          "example",
+		DomainNames:          []string{"example.com"},
+		DoubleEncrypt:        false,
+		Enabled:              true,
+		HealthCheckType:      "DEFAULT",
+		HealthReporting:      "ON_ACCESS",
+		IcmpAccessType:       "NONE",
+		IPAnchored:           false,
+		IsCnameEnabled:       true,
+		PassiveHealthEnabled: true,
+		SegmentGroupID:       segmentGroupID,
+		TCPPortRanges:        []string{"443", "443"},
+		UDPPortRanges:        []string{"53", "53"},
+		// No browser access applications are sent as an empty list.
+		ClientlessApps: []*models.BAAppDto{},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	seededSegmentGroupID := srv.Seed(customerID, "segmentGroup", zpafake.Object{"name": "example"})
+	e := &external{client: zpa.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.ApplicationSegment{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ApplicationSegmentParameters{
+		CustomerID:     customerID,
+		Description:    "example application segment",
+		DomainNames:    []string{"example.com"},
+		SegmentGroupID: zpaclient.String(seededSegmentGroupID),
+		TCPPortRanges:  []string{"443", "443"},
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "application", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want application segment %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpafake.DefaultConfigSpace, cr.Spec.ForProvider.ConfigSpace); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized configSpace, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Description = "updated application segment"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want application segment to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "application", externalName); got["description"] != "updated application segment" {
+		t.Errorf("e.Update(...): want description to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bacertificate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/bacertificate/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/bacertificate"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	errNotBACertificate  = "managed resource is not a BACertificate custom resource"
+	errCreateFailed      = "cannot create BACertificate"
+	errDescribeFailed    = "cannot describe BACertificate"
+	errDeleteFailed      = "cannot delete BACertificate"
+	errImmutable         = "cannot update BACertificate, because the ZPA API cannot update certificates: recreate it to change its description"
+	errGetSecret         = "cannot get certificate secret"
+	errInvalidSecretData = "'%s' is required in certificate secret data"
+)
+
+// SetupBACertificate adds a controller that reconciles BACertificates.
+func SetupBACertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.BACertificateKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.BACertificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BACertificateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: bacertificate.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) bacertificate.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client bacertificate.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.BACertificate)
+	if !ok {
+		return nil, errors.New(errNotBACertificate)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BACertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBACertificate)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &bacertificate.GetBACertificateParams{
+		Context:       ctx,
+		CustomerID:    cr.Spec.ForProvider.CustomerID,
+		CertificateID: id,
+	}
+	obj, reqErr := e.client.GetBACertificate(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	// The certificate secret is only read when the certificate is created,
+	// because the ZPA API cannot update certificates.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, obj),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BACertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBACertificate)
+	}
+
+	cert, key, err := e.getCertificateSecret(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	req := &bacertificate.AddBACertificateParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		BACertificate: &bacertificate.BACertificate{
+			Name:        cr.Name,
+			Description: cr.Spec.ForProvider.Description,
+			CertBlob:    certBlob(cert, key),
+		},
+	}
+
+	obj, err := e.client.AddBACertificate(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.BACertificate); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBACertificate)
+	}
+
+	// The ZPA API has no operation to update a certificate, so a changed
+	// description is reported until the BACertificate is recreated.
+	return managed.ExternalUpdate{}, errors.New(errImmutable)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BACertificate)
+	if !ok {
+		return errors.New(errNotBACertificate)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotBACertificate)
+	}
+
+	req := &bacertificate.DeleteBACertificateParams{
+		Context:       ctx,
+		CustomerID:    cr.Spec.ForProvider.CustomerID,
+		CertificateID: id,
+	}
+
+	if err := e.client.DeleteBACertificate(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+// getCertificateSecret returns the certificate and the private key of the
+// TLS secret referenced by the supplied BACertificate.
+func (e *external) getCertificateSecret(ctx context.Context, cr *v1alpha1.BACertificate) (cert []byte, key []byte, err error) {
+	ref := cr.Spec.ForProvider.CertificateSecretRef
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, nil, errors.Wrap(err, errGetSecret)
+	}
+
+	for _, k := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(s.Data[k]) == 0 {
+			return nil, nil, errors.New(fmt.Sprintf(errInvalidSecretData, k))
+		}
+	}
+
+	return s.Data[corev1.TLSCertKey], s.Data[corev1.TLSPrivateKeyKey], nil
+}
+
+// certBlob concatenates the supplied PEM encoded certificate and private
+// key, as the ZPA API expects them to be uploaded.
+func certBlob(cert, key []byte) string {
+	return string(bytes.TrimSpace(cert)) + "\n" + string(bytes.TrimSpace(key)) + "\n"
+}
+
+// isUpToDate checks whether the name and the description of the supplied
+// certificate match the BACertificate.
+func isUpToDate(cr *v1alpha1.BACertificate, obj *bacertificate.BACertificate) bool {
+	return cr.Name == obj.Name && cr.Spec.ForProvider.Description == obj.Description
+}
+
+// generateObservation generates observation for the input object bacertificate.BACertificate
+func generateObservation(obj *bacertificate.BACertificate) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime:        obj.CreationTime,
+		ID:                  obj.ID,
+		ModifiedBy:          obj.ModifiedBy,
+		ModifiedTime:        obj.ModifiedTime,
+		CName:               obj.CName,
+		IssuedBy:            obj.IssuedBy,
+		IssuedTo:            obj.IssuedTo,
+		San:                 obj.San,
+		SerialNo:            obj.SerialNo,
+		Status:              obj.Status,
+		ValidFromInEpochSec: obj.ValidFromInEpochSec,
+		ValidToInEpochSec:   obj.ValidToInEpochSec,
+	}
+}
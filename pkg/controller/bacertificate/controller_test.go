@@ -0,0 +1,586 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bacertificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/bacertificate/v1alpha1"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/bacertificate"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockbac "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/bacertificate"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID      = "72058304855015424"
+	id              = "72058304855015574"
+	secretName      = "example-tls"
+	secretNamespace = "crossplane-system"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type baCertificateModifier func(*v1alpha1.BACertificate)
+
+func withExternalName(n string) baCertificateModifier {
+	return func(cr *v1alpha1.BACertificate) { meta.SetExternalName(cr, n) }
+}
+
+func withDescription(d string) baCertificateModifier {
+	return func(cr *v1alpha1.BACertificate) { cr.Spec.ForProvider.Description = d }
+}
+
+func withDeletionTimestamp() baCertificateModifier {
+	return func(cr *v1alpha1.BACertificate) {
+		cr.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1633046400, 0)})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) baCertificateModifier {
+	return func(cr *v1alpha1.BACertificate) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) baCertificateModifier {
+	return func(cr *v1alpha1.BACertificate) { cr.Status.AtProvider = o }
+}
+
+func baCertificate(m ...baCertificateModifier) *v1alpha1.BACertificate {
+	cr := &v1alpha1.BACertificate{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.BACertificateParameters{
+		CustomerID:           customerID,
+		Description:          "example",
+		CertificateSecretRef: xpv1.SecretReference{Name: secretName, Namespace: secretNamespace},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// newCertificate returns a PEM encoded self-signed certificate for the
+// supplied domain and its PEM encoded private key.
+func newCertificate(t *testing.T, domain string) (cert []byte, key []byte) {
+	t.Helper()
+
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey(...): %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Unix(1633046400, 0),
+		NotAfter:     time.Unix(1664582400, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &k.PublicKey, k)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(...): %v", err)
+	}
+	kder, err := x509.MarshalPKCS8PrivateKey(k)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKCS8PrivateKey(...): %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: kder})
+}
+
+// kube returns a Kubernetes client that serves the certificate secret with
+// the supplied data.
+func kube(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s, ok := obj.(*corev1.Secret)
+			if !ok || key.Name != secretName || key.Namespace != secretNamespace {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			s.Data = data
+			return nil
+		},
+	}
+}
+
+func tlsData(cert, key []byte) map[string][]byte {
+	return map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key}
+}
+
+func payload(cert []byte, m ...func(*bacertificate.BACertificate)) *bacertificate.BACertificate {
+	p := &bacertificate.BACertificate{
+		ID:                  id,
+		Name:                "example",
+		Description:         "example",
+		Certificate:         string(cert),
+		CName:               "example.com",
+		IssuedBy:            "CN=example.com",
+		IssuedTo:            "CN=example.com",
+		San:                 []string{"example.com"},
+		SerialNo:            "1",
+		Status:              "ACTIVE",
+		ValidFromInEpochSec: "1633046400",
+		ValidToInEpochSec:   "1664582400",
+		CreationTime:        "1633046400",
+		ModifiedBy:          "admin",
+		ModifiedTime:        "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                  id,
+	CreationTime:        "1633046400",
+	ModifiedBy:          "admin",
+	ModifiedTime:        "1633050000",
+	CName:               "example.com",
+	IssuedBy:            "CN=example.com",
+	IssuedTo:            "CN=example.com",
+	San:                 []string{"example.com"},
+	SerialNo:            "1",
+	Status:              "ACTIVE",
+	ValidFromInEpochSec: "1633046400",
+	ValidToInEpochSec:   "1664582400",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotBACertificate": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotBACertificate)},
+		},
+		"NoProviderConfigRef": {
+			mg:   baCertificate(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := baCertificate()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) bacertificate.ClientService {
+					called = true
+					return bacertificate.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	cert, _ := newCertificate(t, "example.com")
+	otherCert, _ := newCertificate(t, "example.org")
+
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	get := func(p *bacertificate.BACertificate) func(m *mockbac.MockClientService) {
+		return func(m *mockbac.MockClientService) {
+			m.EXPECT().GetBACertificate(getParams()).Return(p, nil)
+		}
+	}
+	observed := baCertificate(withExternalName(id), withConditions(xpv1.Available()), withObservation(observation))
+
+	cases := map[string]struct {
+		mock func(m *mockbac.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotBACertificate": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotBACertificate)},
+		},
+		"NoExternalName": {
+			mg:   baCertificate(),
+			want: want{cr: baCertificate()},
+		},
+		"NotFound": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().GetBACertificate(getParams()).Return(nil, errNotFound)
+			},
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: baCertificate(withExternalName(id))},
+		},
+		"GetFailed": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().GetBACertificate(getParams()).Return(nil, errBoom)
+			},
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: baCertificate(withExternalName(id)), err: errors.Wrap(errBoom, errDescribeFailed)},
+		},
+		"UpToDate": {
+			mock: get(payload(cert)),
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"DescriptionChanged": {
+			mock: get(payload(cert, func(p *bacertificate.BACertificate) { p.Description = "changed" })),
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"NameChanged": {
+			mock: get(payload(cert, func(p *bacertificate.BACertificate) { p.Name = "other" })),
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		// The certificate secret is only read when the certificate is created.
+		"CertificateChanged": {
+			mock: get(payload(otherCert)),
+			mg:   baCertificate(withExternalName(id)),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		// The certificate secret may be deleted before the BACertificate.
+		"Deleting": {
+			mock: get(payload(cert)),
+			mg:   baCertificate(withExternalName(id), withDeletionTimestamp()),
+			want: want{
+				cr:  baCertificate(withExternalName(id), withDeletionTimestamp(), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockbac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cert, key := newCertificate(t, "example.com")
+
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockbac.MockClientService)
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"NotBACertificate": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotBACertificate)},
+		},
+		"Success": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().AddBACertificate(&bacertificate.AddBACertificateParams{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					BACertificate: &bacertificate.BACertificate{
+						Name:        "example",
+						Description: "example",
+						CertBlob:    string(cert) + string(key),
+					},
+				}).Return(&bacertificate.BACertificate{ID: id}, nil)
+			},
+			kube: kube(tlsData(cert, key)),
+			mg:   baCertificate(),
+			want: want{
+				cr:  baCertificate(withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"GetSecretFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   baCertificate(),
+			want: want{cr: baCertificate(), err: errors.Wrap(errBoom, errGetSecret)},
+		},
+		"NoPrivateKey": {
+			kube: kube(map[string][]byte{corev1.TLSCertKey: cert}),
+			mg:   baCertificate(),
+			want: want{cr: baCertificate(), err: errors.New(fmt.Sprintf(errInvalidSecretData, corev1.TLSPrivateKeyKey))},
+		},
+		"CreateFailed": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().AddBACertificate(gomock.Any()).Return(nil, errBoom)
+			},
+			kube: kube(tlsData(cert, key)),
+			mg:   baCertificate(),
+			want: want{cr: baCertificate(), err: errors.Wrap(errBoom, errCreateFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockbac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m, kube: tc.kube}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want error
+	}{
+		"NotBACertificate": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotBACertificate),
+		},
+		"Immutable": {
+			mg:   baCertificate(withExternalName(id), withDescription("changed")),
+			want: errors.New(errImmutable),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockbac.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotBACertificate": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotBACertificate),
+		},
+		"NoExternalName": {
+			mg:   baCertificate(),
+			want: errors.New(errNotBACertificate),
+		},
+		"Success": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().DeleteBACertificate(&bacertificate.DeleteBACertificateParams{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					CertificateID: id,
+				}).Return(nil)
+			},
+			mg: baCertificate(withExternalName(id)),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().DeleteBACertificate(gomock.Any()).Return(errNotFound)
+			},
+			mg: baCertificate(withExternalName(id)),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockbac.MockClientService) {
+				m.EXPECT().DeleteBACertificate(gomock.Any()).Return(errBoom)
+			},
+			mg:   baCertificate(withExternalName(id)),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockbac.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *bacertificate.GetBACertificateParams {
+	return &bacertificate.GetBACertificateParams{
+		Context:       context.Background(),
+		CustomerID:    customerID,
+		CertificateID: id,
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	cert, key := newCertificate(t, "example.com")
+	otherCert, otherKey := newCertificate(t, "example.org")
+
+	ctx := context.Background()
+	e := &external{client: bacertificate.New(srv.Transport(), strfmt.Default), kube: kube(tlsData(cert, key))}
+
+	cr := baCertificate()
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	got, ok := srv.Get(customerID, "certificate", externalName)
+	if !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want certificate %q to be created, got %v", externalName, got)
+	}
+	if _, ok := got["certBlob"]; ok {
+		t.Errorf("e.Create(...): want private key not to be returned, got %v", got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	// The certificate is only uploaded when it is created.
+	e.kube = kube(tlsData(otherCert, otherKey))
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want rotated certificate to be ignored, got %+v, %v", obs, err)
+	}
+
+	withDescription("changed")(cr)
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want changed description to be reported, got %+v, %v", obs, err)
+	}
+	if _, err := e.Update(ctx, cr); err == nil {
+		t.Errorf("e.Update(...): want error for changed description")
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrollmentcert
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/enrollmentcert/v1alpha1"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+)
+
+const (
+	errNotEnrollmentCert  = "managed resource is not an EnrollmentCert custom resource"
+	errCreateNotSupported = "enrollment certificates are generated by the tenant and cannot be created, check spec.forProvider.name"
+)
+
+// SetupEnrollmentCert adds a controller that reconciles EnrollmentCerts.
+func SetupEnrollmentCert(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.EnrollmentCertKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.EnrollmentCert{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnrollmentCertGroupVersionKind),
+			managed.WithExternalConnecter(&lookup.Connector{
+				Kube:               mgr.GetClient(),
+				NewClientFn:        lookup.New,
+				Logger:             logger,
+				Recorder:           recorder,
+				Kind:               v1alpha1.EnrollmentCertKind,
+				CreateNotSupported: errCreateNotSupported,
+				Lookup:             lookupEnrollmentCert,
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// lookupEnrollmentCert looks up the enrollment certificate of the supplied EnrollmentCert by its name.
+// It returns the ID of the enrollment certificate.
+func lookupEnrollmentCert(ctx context.Context, client lookup.ClientService, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*v1alpha1.EnrollmentCert)
+	if !ok {
+		return "", errors.New(errNotEnrollmentCert)
+	}
+
+	obj, err := client.GetEnrollmentCertByName(&lookup.GetEnrollmentCertByNameParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		Name:       cr.Spec.ForProvider.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	return obj.ID, nil
+}
+
+// generateObservation generates observation for the input object lookup.EnrollmentCert
+func generateObservation(obj *lookup.EnrollmentCert) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime:        obj.CreationTime,
+		ID:                  obj.ID,
+		ModifiedBy:          obj.ModifiedBy,
+		ModifiedTime:        obj.ModifiedTime,
+		Description:         obj.Description,
+		ClientCertType:      obj.ClientCertType,
+		IssuedBy:            obj.IssuedBy,
+		IssuedTo:            obj.IssuedTo,
+		ParentCertID:        obj.ParentCertID,
+		ParentCertName:      obj.ParentCertName,
+		SerialNo:            obj.SerialNo,
+		ValidFromInEpochSec: obj.ValidFromInEpochSec,
+		ValidToInEpochSec:   obj.ValidToInEpochSec,
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enrollmentcert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/enrollmentcert/v1alpha1"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID         = "72058304855015424"
+	id                 = "72058304855015574"
+	enrollmentCertName = "Connector"
+)
+
+type enrollmentCertModifier func(*v1alpha1.EnrollmentCert)
+
+func withObservation(o v1alpha1.Observation) enrollmentCertModifier {
+	return func(cr *v1alpha1.EnrollmentCert) { cr.Status.AtProvider = o }
+}
+
+func enrollmentCert(m ...enrollmentCertModifier) *v1alpha1.EnrollmentCert {
+	cr := &v1alpha1.EnrollmentCert{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.EnrollmentCertParameters{CustomerID: customerID, Name: enrollmentCertName}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func payload() *lookup.EnrollmentCert {
+	return &lookup.EnrollmentCert{
+		ID:                  id,
+		Name:                enrollmentCertName,
+		Description:         "Connector enrollment certificate",
+		AllowSigning:        true,
+		ClientCertType:      "ZAPP_CLIENT",
+		IssuedBy:            "CN=Root",
+		IssuedTo:            "CN=Connector",
+		ParentCertID:        "2517",
+		ParentCertName:      "Root",
+		SerialNo:            "1633046400123",
+		ValidFromInEpochSec: "1633046400",
+		ValidToInEpochSec:   "2581817600",
+		CreationTime:        "1633046400",
+		ModifiedBy:          "admin",
+		ModifiedTime:        "1633050000",
+	}
+}
+
+var observation = v1alpha1.Observation{
+	ID:                  id,
+	CreationTime:        "1633046400",
+	ModifiedBy:          "admin",
+	ModifiedTime:        "1633050000",
+	Description:         "Connector enrollment certificate",
+	ClientCertType:      "ZAPP_CLIENT",
+	IssuedBy:            "CN=Root",
+	IssuedTo:            "CN=Connector",
+	ParentCertID:        "2517",
+	ParentCertName:      "Root",
+	SerialNo:            "1633046400123",
+	ValidFromInEpochSec: "1633046400",
+	ValidToInEpochSec:   "2581817600",
+}
+
+func TestLookupEnrollmentCert(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		id  string
+		err error
+	}
+
+	getParams := &lookup.GetEnrollmentCertByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		Name:       enrollmentCertName,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklookup.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotEnrollmentCert": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotEnrollmentCert)},
+		},
+		"Found": {
+			mock: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetEnrollmentCertByName(getParams).Return(payload(), nil)
+			},
+			mg:   enrollmentCert(),
+			want: want{cr: enrollmentCert(withObservation(observation)), id: id},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklookup.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+
+			id, err := lookupEnrollmentCert(context.Background(), m, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nlookupEnrollmentCert(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\nlookupEnrollmentCert(...): -want ID, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\nlookupEnrollmentCert(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
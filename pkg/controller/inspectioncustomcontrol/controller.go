@@ -0,0 +1,307 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectioncustomcontrol
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectioncustomcontrol/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/inspection"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	errNotInspectionCustomControl = "managed resource is not an InspectionCustomControl custom resource"
+	errCreateFailed               = "cannot create InspectionCustomControl"
+	errUpdateFailed               = "cannot update InspectionCustomControl"
+	errDescribeFailed             = "cannot describe InspectionCustomControl"
+	errDeleteFailed               = "cannot delete InspectionCustomControl"
+)
+
+// SetupInspectionCustomControl adds a controller that reconciles
+// InspectionCustomControls.
+func SetupInspectionCustomControl(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InspectionCustomControlKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.InspectionCustomControl{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InspectionCustomControlGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: inspection.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) inspection.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client inspection.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.InspectionCustomControl)
+	if !ok {
+		return nil, errors.New(errNotInspectionCustomControl)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InspectionCustomControl)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInspectionCustomControl)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &inspection.GetCustomControlParams{
+		Context:         ctx,
+		CustomerID:      cr.Spec.ForProvider.CustomerID,
+		CustomControlID: id,
+	}
+	obj, reqErr := e.client.GetCustomControl(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	e.LateInitialize(cr, obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(&cr.Spec.ForProvider, obj),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InspectionCustomControl)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInspectionCustomControl)
+	}
+
+	req := &inspection.AddCustomControlParams{
+		Context:       ctx,
+		CustomerID:    cr.Spec.ForProvider.CustomerID,
+		CustomControl: generateCustomControl(cr),
+	}
+
+	obj, err := e.client.AddCustomControl(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.InspectionCustomControl)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInspectionCustomControl)
+	}
+
+	req := &inspection.UpdateCustomControlParams{
+		Context:         ctx,
+		CustomerID:      cr.Spec.ForProvider.CustomerID,
+		CustomControlID: meta.GetExternalName(cr),
+		CustomControl:   generateCustomControl(cr),
+	}
+
+	if err := e.client.UpdateCustomControl(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InspectionCustomControl)
+	if !ok {
+		return errors.New(errNotInspectionCustomControl)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotInspectionCustomControl)
+	}
+
+	req := &inspection.DeleteCustomControlParams{
+		Context:         ctx,
+		CustomerID:      cr.Spec.ForProvider.CustomerID,
+		CustomControlID: id,
+	}
+
+	if err := e.client.DeleteCustomControl(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+func (e *external) LateInitialize(cr *v1alpha1.InspectionCustomControl, obj *inspection.CustomControl) {
+	p := &cr.Spec.ForProvider
+
+	if p.ParanoiaLevel == "" && obj.ParanoiaLevel != "" {
+		p.ParanoiaLevel = obj.ParanoiaLevel
+	}
+}
+
+// generateCustomControl generates the inspection.CustomControl that is sent
+// to the ZPA API for the supplied InspectionCustomControl.
+func generateCustomControl(cr *v1alpha1.InspectionCustomControl) *inspection.CustomControl {
+	p := cr.Spec.ForProvider
+
+	return &inspection.CustomControl{
+		Name:          cr.Name,
+		Description:   p.Description,
+		ControlType:   inspection.ControlTypeCustom,
+		Type:          p.Type,
+		Action:        p.Action,
+		ActionValue:   p.ActionValue,
+		Severity:      p.Severity,
+		ParanoiaLevel: p.ParanoiaLevel,
+		Rules:         generateRules(p.Rules),
+	}
+}
+
+// generateRules generates the rules of a custom control that are sent to
+// the ZPA API.
+func generateRules(in []v1alpha1.Rule) []inspection.Rule {
+	out := make([]inspection.Rule, len(in))
+	for i, r := range in {
+		out[i] = inspection.Rule{
+			Type:       r.Type,
+			Names:      r.Names,
+			Conditions: make([]inspection.Condition, len(r.Conditions)),
+		}
+		for j, c := range r.Conditions {
+			out[i].Conditions[j] = inspection.Condition{LHS: c.LHS, OP: c.OP, RHS: c.RHS}
+		}
+	}
+	return out
+}
+
+// generateObservation generates observation for the input object inspection.CustomControl
+func generateObservation(obj *inspection.CustomControl) v1alpha1.Observation {
+	o := v1alpha1.Observation{
+		CreationTime:  obj.CreationTime,
+		ID:            obj.ID,
+		ModifiedBy:    obj.ModifiedBy,
+		ModifiedTime:  obj.ModifiedTime,
+		ControlNumber: obj.ControlNumber,
+	}
+	for _, p := range obj.AssociatedInspectionProfileNames {
+		o.InspectionProfiles = append(o.InspectionProfiles, p.Name)
+	}
+	return o
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.InspectionCustomControlParameters, obj *inspection.CustomControl) bool {
+	if !isEqualRules(generateRules(cr.Rules), obj.Rules) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Description, obj.Description},
+		{cr.Type, obj.Type},
+		{cr.Action, obj.Action},
+		{cr.ActionValue, obj.ActionValue},
+		{cr.Severity, obj.Severity},
+		{cr.ParanoiaLevel, obj.ParanoiaLevel},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isEqualRules returns whether the observed rules of a custom control are
+// the supplied rules. The names of a rule may be observed in any order.
+func isEqualRules(in, observed []inspection.Rule) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for i := range in {
+		if in[i].Type != observed[i].Type || !zpaclient.IsEqualStringArrayContent(in[i].Names, observed[i].Names) {
+			return false
+		}
+		if len(in[i].Conditions) != len(observed[i].Conditions) {
+			return false
+		}
+		for j := range in[i].Conditions {
+			if in[i].Conditions[j] != observed[i].Conditions[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
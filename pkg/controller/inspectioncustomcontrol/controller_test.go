@@ -0,0 +1,593 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectioncustomcontrol
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectioncustomcontrol/v1alpha1"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/inspection"
+	mockinspection "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/inspection"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type customControlModifier func(*v1alpha1.InspectionCustomControl)
+
+func withExternalName(n string) customControlModifier {
+	return func(cr *v1alpha1.InspectionCustomControl) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.InspectionCustomControlParameters) customControlModifier {
+	return func(cr *v1alpha1.InspectionCustomControl) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) customControlModifier {
+	return func(cr *v1alpha1.InspectionCustomControl) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) customControlModifier {
+	return func(cr *v1alpha1.InspectionCustomControl) { cr.Status.AtProvider = o }
+}
+
+func customControl(m ...customControlModifier) *v1alpha1.InspectionCustomControl {
+	cr := &v1alpha1.InspectionCustomControl{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.InspectionCustomControlParameters)) v1alpha1.InspectionCustomControlParameters {
+	p := v1alpha1.InspectionCustomControlParameters{
+		CustomerID:    customerID,
+		Description:   "example",
+		Type:          "REQUEST",
+		Action:        "BLOCK",
+		Severity:      "CRITICAL",
+		ParanoiaLevel: "1",
+		Rules: []v1alpha1.Rule{
+			{
+				Type:  "REQUEST_HEADERS",
+				Names: []string{"User-Agent", "X-Scanner"},
+				Conditions: []v1alpha1.RuleCondition{
+					{LHS: "VALUE", OP: "CONTAINS", RHS: "sqlmap"},
+				},
+			},
+			{
+				Type: "REQUEST_URI",
+				Conditions: []v1alpha1.RuleCondition{
+					{LHS: "SIZE", OP: "GE", RHS: "2048"},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*inspection.CustomControl)) *inspection.CustomControl {
+	p := model()
+	p.ID = id
+	p.ControlNumber = "4500001"
+	p.AssociatedInspectionProfileNames = []inspection.AssociatedProfile{{ID: "72058304855015580", Name: "example"}}
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                 id,
+	CreationTime:       "1633046400",
+	ModifiedBy:         "admin",
+	ModifiedTime:       "1633050000",
+	ControlNumber:      "4500001",
+	InspectionProfiles: []string{"example"},
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionCustomControl": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotInspectionCustomControl)},
+		},
+		"NoProviderConfigRef": {
+			mg:   customControl(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := customControl()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) inspection.ClientService {
+					called = true
+					return inspection.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*inspection.CustomControl)) func(m *mockinspection.MockClientService) {
+		return func(m *mockinspection.MockClientService) {
+			m.EXPECT().GetCustomControl(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := customControl(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionCustomControl": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotInspectionCustomControl)},
+		},
+		"NoExternalName": {
+			mg:   customControl(withSpec(params())),
+			want: want{cr: customControl(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().GetCustomControl(getParams()).Return(nil, errNotFound)
+			},
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: want{cr: customControl(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().GetCustomControl(getParams()).Return(nil, errBoom)
+			},
+			mg: customControl(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  customControl(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*inspection.CustomControl) {}),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"UpToDateNamesInAnyOrder": {
+			mock: drift(func(p *inspection.CustomControl) { p.Rules[0].Names = []string{"X-Scanner", "User-Agent"} }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*inspection.CustomControl) {}),
+			mg: customControl(withExternalName(id), withSpec(params(func(p *v1alpha1.InspectionCustomControlParameters) {
+				p.ParanoiaLevel = ""
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Description = "changed" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"TypeDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Type = "RESPONSE" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ActionDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Action = "PASS" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ActionValueDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.ActionValue = "https://example.com/blocked" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"SeverityDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Severity = "INFO" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ParanoiaLevelDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.ParanoiaLevel = "4" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"RuleRemoved": {
+			mock: drift(func(p *inspection.CustomControl) { p.Rules = p.Rules[:1] }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"RuleTypeDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Rules[1].Type = "QUERY_STRING" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"RuleNamesDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Rules[0].Names = []string{"User-Agent"} }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"RuleConditionDrift": {
+			mock: drift(func(p *inspection.CustomControl) { p.Rules[0].Conditions[0].RHS = "nikto" }),
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionCustomControl": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotInspectionCustomControl)},
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().AddCustomControl(&inspection.AddCustomControlParams{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					CustomControl: model(),
+				}).Return(&inspection.CustomControl{ID: id}, nil)
+			},
+			mg: customControl(withSpec(params())),
+			want: want{
+				cr:  customControl(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().AddCustomControl(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: customControl(withSpec(params())),
+			want: want{
+				cr:  customControl(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotInspectionCustomControl": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotInspectionCustomControl),
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().UpdateCustomControl(&inspection.UpdateCustomControlParams{
+					Context:         context.Background(),
+					CustomerID:      customerID,
+					CustomControlID: id,
+					CustomControl:   model(),
+				}).Return(nil)
+			},
+			mg: customControl(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().UpdateCustomControl(gomock.Any()).Return(errBoom)
+			},
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotInspectionCustomControl": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotInspectionCustomControl),
+		},
+		"NoExternalName": {
+			mg:   customControl(withSpec(params())),
+			want: errors.New(errNotInspectionCustomControl),
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteCustomControl(&inspection.DeleteCustomControlParams{
+					Context:         context.Background(),
+					CustomerID:      customerID,
+					CustomControlID: id,
+				}).Return(nil)
+			},
+			mg: customControl(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteCustomControl(gomock.Any()).Return(errNotFound)
+			},
+			mg: customControl(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteCustomControl(gomock.Any()).Return(errBoom)
+			},
+			mg:   customControl(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *inspection.GetCustomControlParams {
+	return &inspection.GetCustomControlParams{
+		Context:         context.Background(),
+		CustomerID:      customerID,
+		CustomControlID: id,
+	}
+}
+
+// model returns the CustomControl that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *inspection.CustomControl {
+	return &inspection.CustomControl{
+		Name:          "example",
+		Description:   "example",
+		ControlType:   inspection.ControlTypeCustom,
+		Type:          "REQUEST",
+		Action:        "BLOCK",
+		Severity:      "CRITICAL",
+		ParanoiaLevel: "1",
+		Rules: []inspection.Rule{
+			{
+				Type:  "REQUEST_HEADERS",
+				Names: []string{"User-Agent", "X-Scanner"},
+				Conditions: []inspection.Condition{
+					{LHS: "VALUE", OP: "CONTAINS", RHS: "sqlmap"},
+				},
+			},
+			{
+				Type: "REQUEST_URI",
+				Conditions: []inspection.Condition{
+					{LHS: "SIZE", OP: "GE", RHS: "2048"},
+				},
+			},
+		},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: inspection.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.InspectionCustomControl{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = params()
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "inspectionControls/custom", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want custom control %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Rules[0].Conditions[0].RHS = "nikto"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want custom control to be up to date after update, got %+v, %v", obs, err)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
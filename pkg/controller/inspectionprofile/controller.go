@@ -0,0 +1,333 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectionprofile
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectionprofile/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/inspection"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	errNotInspectionProfile = "managed resource is not an InspectionProfile custom resource"
+	errCreateFailed         = "cannot create InspectionProfile"
+	errUpdateFailed         = "cannot update InspectionProfile"
+	errDescribeFailed       = "cannot describe InspectionProfile"
+	errDeleteFailed         = "cannot delete InspectionProfile"
+)
+
+// SetupInspectionProfile adds a controller that reconciles InspectionProfiles.
+func SetupInspectionProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.InspectionProfileKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.InspectionProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InspectionProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: inspection.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) inspection.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client inspection.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.InspectionProfile)
+	if !ok {
+		return nil, errors.New(errNotInspectionProfile)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InspectionProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInspectionProfile)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &inspection.GetInspectionProfileParams{
+		Context:             ctx,
+		CustomerID:          cr.Spec.ForProvider.CustomerID,
+		InspectionProfileID: id,
+	}
+	obj, reqErr := e.client.GetInspectionProfile(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	e.LateInitialize(cr, obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(&cr.Spec.ForProvider, obj),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InspectionProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInspectionProfile)
+	}
+
+	req := &inspection.AddInspectionProfileParams{
+		Context:           ctx,
+		CustomerID:        cr.Spec.ForProvider.CustomerID,
+		InspectionProfile: generateInspectionProfile(cr),
+	}
+
+	obj, err := e.client.AddInspectionProfile(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.InspectionProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInspectionProfile)
+	}
+
+	req := &inspection.UpdateInspectionProfileParams{
+		Context:             ctx,
+		CustomerID:          cr.Spec.ForProvider.CustomerID,
+		InspectionProfileID: meta.GetExternalName(cr),
+		InspectionProfile:   generateInspectionProfile(cr),
+	}
+
+	if err := e.client.UpdateInspectionProfile(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InspectionProfile)
+	if !ok {
+		return errors.New(errNotInspectionProfile)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotInspectionProfile)
+	}
+
+	req := &inspection.DeleteInspectionProfileParams{
+		Context:             ctx,
+		CustomerID:          cr.Spec.ForProvider.CustomerID,
+		InspectionProfileID: id,
+	}
+
+	if err := e.client.DeleteInspectionProfile(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+// LateInitialize the predefined controls and the global control actions,
+// which ZPA defaults for the paranoia level, and the actions of controls,
+// which default to the action of the control.
+func (e *external) LateInitialize(cr *v1alpha1.InspectionProfile, obj *inspection.InspectionProfile) { // nolint:gocyclo
+	p := &cr.Spec.ForProvider
+
+	if p.PredefinedControlsVersion == "" && obj.PredefinedControlsVersion != "" {
+		p.PredefinedControlsVersion = obj.PredefinedControlsVersion
+	}
+
+	if len(p.GlobalControlActions) == 0 && len(obj.GlobalControlActions) > 0 {
+		p.GlobalControlActions = obj.GlobalControlActions
+	}
+
+	if len(p.PredefinedControls) == 0 && len(obj.PredefinedControls) > 0 {
+		p.PredefinedControls = make([]v1alpha1.PredefinedControl, len(obj.PredefinedControls))
+		for i, c := range obj.PredefinedControls {
+			p.PredefinedControls[i] = v1alpha1.PredefinedControl{ID: c.ID, Action: c.Action, ActionValue: c.ActionValue}
+		}
+	}
+
+	for i := range p.PredefinedControls {
+		c := &p.PredefinedControls[i]
+		if o := controlByID(obj.PredefinedControls, c.ID); c.Action == "" && o != nil {
+			c.Action = o.Action
+		}
+	}
+
+	for i := range p.CustomControls {
+		c := &p.CustomControls[i]
+		if o := controlByID(obj.CustomControls, zpaclient.StringValue(c.ID)); c.Action == "" && o != nil {
+			c.Action = o.Action
+		}
+	}
+}
+
+// generateInspectionProfile generates the inspection.InspectionProfile that
+// is sent to the ZPA API for the supplied InspectionProfile.
+func generateInspectionProfile(cr *v1alpha1.InspectionProfile) *inspection.InspectionProfile {
+	p := cr.Spec.ForProvider
+
+	out := &inspection.InspectionProfile{
+		Name:                      cr.Name,
+		Description:               p.Description,
+		ParanoiaLevel:             p.ParanoiaLevel,
+		PredefinedControlsVersion: p.PredefinedControlsVersion,
+		PredefinedControls:        make([]inspection.Control, len(p.PredefinedControls)),
+		CustomControls:            make([]inspection.Control, len(p.CustomControls)),
+		GlobalControlActions:      p.GlobalControlActions,
+	}
+	for i, c := range p.PredefinedControls {
+		out.PredefinedControls[i] = inspection.Control{ID: c.ID, Action: c.Action, ActionValue: c.ActionValue}
+	}
+	for i, c := range p.CustomControls {
+		out.CustomControls[i] = inspection.Control{ID: zpaclient.StringValue(c.ID), Action: c.Action, ActionValue: c.ActionValue}
+	}
+	return out
+}
+
+// controlByID returns the control with the supplied ID, or nil if there is
+// none.
+func controlByID(controls []inspection.Control, id string) *inspection.Control {
+	for i := range controls {
+		if controls[i].ID == id {
+			return &controls[i]
+		}
+	}
+	return nil
+}
+
+// isEqualControls returns whether the observed controls are the supplied
+// controls, in any order.
+func isEqualControls(in, observed []inspection.Control) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for _, c := range in {
+		o := controlByID(observed, c.ID)
+		if o == nil || c.Action != o.Action || c.ActionValue != o.ActionValue {
+			return false
+		}
+	}
+	return true
+}
+
+// generateObservation generates observation for the input object inspection.InspectionProfile
+func generateObservation(obj *inspection.InspectionProfile) v1alpha1.Observation {
+	o := v1alpha1.Observation{
+		CreationTime: obj.CreationTime,
+		ID:           obj.ID,
+		ModifiedBy:   obj.ModifiedBy,
+		ModifiedTime: obj.ModifiedTime,
+	}
+	for _, i := range obj.ControlInfoResource {
+		o.ControlsInfo = append(o.ControlsInfo, v1alpha1.ControlInfo{ControlType: i.ControlType, Count: i.Count})
+	}
+	return o
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.InspectionProfileParameters, obj *inspection.InspectionProfile) bool {
+	want := generateInspectionProfile(&v1alpha1.InspectionProfile{Spec: v1alpha1.InspectionProfileSpec{ForProvider: *cr}})
+
+	if !isEqualControls(want.PredefinedControls, obj.PredefinedControls) {
+		return false
+	}
+
+	if !isEqualControls(want.CustomControls, obj.CustomControls) {
+		return false
+	}
+
+	if !zpaclient.IsEqualStringArrayContent(cr.GlobalControlActions, obj.GlobalControlActions) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Description, obj.Description},
+		{cr.ParanoiaLevel, obj.ParanoiaLevel},
+		{cr.PredefinedControlsVersion, obj.PredefinedControlsVersion},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,577 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectionprofile
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/inspectionprofile/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/inspection"
+	mockinspection "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/inspection"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID      = "72058304855015424"
+	id              = "72058304855015580"
+	customControlID = "72058304855015574"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type profileModifier func(*v1alpha1.InspectionProfile)
+
+func withExternalName(n string) profileModifier {
+	return func(cr *v1alpha1.InspectionProfile) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.InspectionProfileParameters) profileModifier {
+	return func(cr *v1alpha1.InspectionProfile) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) profileModifier {
+	return func(cr *v1alpha1.InspectionProfile) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) profileModifier {
+	return func(cr *v1alpha1.InspectionProfile) { cr.Status.AtProvider = o }
+}
+
+func profile(m ...profileModifier) *v1alpha1.InspectionProfile {
+	cr := &v1alpha1.InspectionProfile{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.InspectionProfileParameters)) v1alpha1.InspectionProfileParameters {
+	p := v1alpha1.InspectionProfileParameters{
+		CustomerID:                customerID,
+		Description:               "example",
+		ParanoiaLevel:             "1",
+		PredefinedControlsVersion: "OWASP_CRS/3.3.0",
+		PredefinedControls: []v1alpha1.PredefinedControl{
+			{ID: "72058304855015590", Action: "BLOCK"},
+			{ID: "72058304855015591", Action: "PASS"},
+		},
+		CustomControls: []v1alpha1.CustomControl{
+			{ID: zpaclient.String(customControlID), Action: "REDIRECT", ActionValue: "https://example.com/blocked"},
+		},
+		GlobalControlActions: []string{"PREDEFINED:PASS", "CUSTOM:NONE"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*inspection.InspectionProfile)) *inspection.InspectionProfile {
+	p := model()
+	p.ID = id
+	p.ControlInfoResource = []inspection.ControlInfo{
+		{ControlType: "PREDEFINED", Count: "2"},
+		{ControlType: "CUSTOM", Count: "1"},
+	}
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	ControlsInfo: []v1alpha1.ControlInfo{
+		{ControlType: "PREDEFINED", Count: "2"},
+		{ControlType: "CUSTOM", Count: "1"},
+	},
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionProfile": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotInspectionProfile)},
+		},
+		"NoProviderConfigRef": {
+			mg:   profile(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := profile()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) inspection.ClientService {
+					called = true
+					return inspection.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*inspection.InspectionProfile)) func(m *mockinspection.MockClientService) {
+		return func(m *mockinspection.MockClientService) {
+			m.EXPECT().GetInspectionProfile(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := profile(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionProfile": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotInspectionProfile)},
+		},
+		"NoExternalName": {
+			mg:   profile(withSpec(params())),
+			want: want{cr: profile(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().GetInspectionProfile(getParams()).Return(nil, errNotFound)
+			},
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: want{cr: profile(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().GetInspectionProfile(getParams()).Return(nil, errBoom)
+			},
+			mg: profile(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  profile(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*inspection.InspectionProfile) {}),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"UpToDateControlsInAnyOrder": {
+			mock: drift(func(p *inspection.InspectionProfile) {
+				p.PredefinedControls[0], p.PredefinedControls[1] = p.PredefinedControls[1], p.PredefinedControls[0]
+				p.GlobalControlActions = []string{"CUSTOM:NONE", "PREDEFINED:PASS"}
+			}),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*inspection.InspectionProfile) {}),
+			mg: profile(withExternalName(id), withSpec(params(func(p *v1alpha1.InspectionProfileParameters) {
+				p.PredefinedControlsVersion = ""
+				p.PredefinedControls = nil
+				p.CustomControls[0].Action = ""
+				p.GlobalControlActions = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.Description = "changed" }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ParanoiaLevelDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.ParanoiaLevel = "3" }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"PredefinedControlsVersionDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.PredefinedControlsVersion = "OWASP_CRS/3.2.0" }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"PredefinedControlActionDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.PredefinedControls[0].Action = "PASS" }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"PredefinedControlRemoved": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.PredefinedControls = p.PredefinedControls[:1] }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CustomControlRemoved": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.CustomControls = nil }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CustomControlActionValueDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.CustomControls[0].ActionValue = "https://example.com" }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"GlobalControlActionsDrift": {
+			mock: drift(func(p *inspection.InspectionProfile) { p.GlobalControlActions = []string{"PREDEFINED:PASS"} }),
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotInspectionProfile": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotInspectionProfile)},
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().AddInspectionProfile(&inspection.AddInspectionProfileParams{
+					Context:           context.Background(),
+					CustomerID:        customerID,
+					InspectionProfile: model(),
+				}).Return(&inspection.InspectionProfile{ID: id}, nil)
+			},
+			mg: profile(withSpec(params())),
+			want: want{
+				cr:  profile(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().AddInspectionProfile(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: profile(withSpec(params())),
+			want: want{
+				cr:  profile(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotInspectionProfile": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotInspectionProfile),
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().UpdateInspectionProfile(&inspection.UpdateInspectionProfileParams{
+					Context:             context.Background(),
+					CustomerID:          customerID,
+					InspectionProfileID: id,
+					InspectionProfile:   model(),
+				}).Return(nil)
+			},
+			mg: profile(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().UpdateInspectionProfile(gomock.Any()).Return(errBoom)
+			},
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockinspection.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotInspectionProfile": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotInspectionProfile),
+		},
+		"NoExternalName": {
+			mg:   profile(withSpec(params())),
+			want: errors.New(errNotInspectionProfile),
+		},
+		"Success": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteInspectionProfile(&inspection.DeleteInspectionProfileParams{
+					Context:             context.Background(),
+					CustomerID:          customerID,
+					InspectionProfileID: id,
+				}).Return(nil)
+			},
+			mg: profile(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteInspectionProfile(gomock.Any()).Return(errNotFound)
+			},
+			mg: profile(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockinspection.MockClientService) {
+				m.EXPECT().DeleteInspectionProfile(gomock.Any()).Return(errBoom)
+			},
+			mg:   profile(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockinspection.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *inspection.GetInspectionProfileParams {
+	return &inspection.GetInspectionProfileParams{
+		Context:             context.Background(),
+		CustomerID:          customerID,
+		InspectionProfileID: id,
+	}
+}
+
+// model returns the InspectionProfile that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *inspection.InspectionProfile {
+	return &inspection.InspectionProfile{
+		Name:                      "example",
+		Description:               "example",
+		ParanoiaLevel:             "1",
+		PredefinedControlsVersion: "OWASP_CRS/3.3.0",
+		PredefinedControls: []inspection.Control{
+			{ID: "72058304855015590", Action: "BLOCK"},
+			{ID: "72058304855015591", Action: "PASS"},
+		},
+		CustomControls: []inspection.Control{
+			{ID: customControlID, Action: "REDIRECT", ActionValue: "https://example.com/blocked"},
+		},
+		GlobalControlActions: []string{"PREDEFINED:PASS", "CUSTOM:NONE"},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: inspection.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.InspectionProfile{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = params()
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "inspectionProfile", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want inspection profile %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.ParanoiaLevel = "2"
+	cr.Spec.ForProvider.PredefinedControls[0].Action = "PASS"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want inspection profile to be up to date after update, got %+v, %v", obs, err)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isolationpolicyrule
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/isolationpolicyrule/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+)
+
+const (
+	errNotIsolationPolicyRule = "managed resource is not an IsolationPolicyRule custom resource"
+	errGetProfileFailed       = "cannot get isolation profile"
+)
+
+// SetupIsolationPolicyRule adds a controller that reconciles
+// IsolationPolicyRules.
+func SetupIsolationPolicyRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.IsolationPolicyRuleKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.IsolationPolicyRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IsolationPolicyRuleGroupVersionKind),
+			managed.WithExternalConnecter(&policyrule.Connector{
+				Kube:        mgr.GetClient(),
+				NewClientFn: policyrule.New,
+				Logger:      logger,
+				Recorder:    recorder,
+				Kind:        v1alpha1.IsolationPolicyRuleKind,
+				PolicyType:  policyrule.PolicyTypeIsolation,
+				NewRuleFn: func(transport runtime.ClientTransport) policyrule.RuleFn {
+					return ruleFn(lookup.New(transport, strfmt.Default))
+				},
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// rule adapts an IsolationPolicyRule to the policyrule.External. It looks up
+// the isolation profile of the rule by its name.
+type rule struct {
+	cr     *v1alpha1.IsolationPolicyRule
+	lookup lookup.ClientService
+}
+
+// ruleFn returns a policyrule.RuleFn that looks up isolation profiles with the
+// supplied client.
+func ruleFn(l lookup.ClientService) policyrule.RuleFn {
+	return func(mg resource.Managed) (policyrule.Rule, error) {
+		cr, ok := mg.(*v1alpha1.IsolationPolicyRule)
+		if !ok {
+			return nil, errors.New(errNotIsolationPolicyRule)
+		}
+		return &rule{cr: cr, lookup: l}, nil
+	}
+}
+
+func (r *rule) CustomerID() string {
+	return r.cr.Spec.ForProvider.CustomerID
+}
+
+func (r *rule) RuleOrder() *int32 {
+	return r.cr.Spec.ForProvider.RuleOrder
+}
+
+func (r *rule) ObservedRuleOrder() int32 {
+	return r.cr.Status.AtProvider.RuleOrder
+}
+
+func (r *rule) Generate(ctx context.Context) (*models.PolicyRule, error) {
+	if err := r.lookupIsolationProfile(ctx); err != nil {
+		return nil, err
+	}
+	return generateIsolationPolicyRule(r.cr, r.cr.Status.AtProvider.ZpnIsolationProfileID), nil
+}
+
+func (r *rule) Observe(ctx context.Context, obj *models.PolicyRule) (bool, bool, error) {
+	p := &r.cr.Spec.ForProvider
+
+	// The isolation profile is looked up by its name on every observation,
+	// because a profile that is recreated under the same name gets a new ID.
+	// A rule that is being deleted does not need its isolation profile.
+	profile := r.cr.Status.AtProvider
+	r.cr.Status.AtProvider = generateObservation(obj)
+	r.cr.Status.AtProvider.ZpnIsolationProfileName = profile.ZpnIsolationProfileName
+	r.cr.Status.AtProvider.ZpnIsolationProfileID = profile.ZpnIsolationProfileID
+	if !meta.WasDeleted(r.cr) {
+		if err := r.lookupIsolationProfile(ctx); err != nil {
+			return false, false, err
+		}
+	}
+
+	lateInitialized := policyrule.LateInitialize(&p.Operator, &p.RuleOrder, obj)
+
+	return isUpToDate(p, r.cr.Status.AtProvider.ZpnIsolationProfileID, obj), lateInitialized, nil
+}
+
+// lookupIsolationProfile looks up the ID of the isolation profile of the rule
+// by its name and records both in the status of its IsolationPolicyRule.
+// Rules without an isolation profile have an empty ID.
+func (r *rule) lookupIsolationProfile(ctx context.Context) error {
+	name := r.cr.Spec.ForProvider.ZpnIsolationProfileName
+	profileID := ""
+	if name != "" {
+		profile, err := r.lookup.GetIsolationProfileByName(&lookup.GetIsolationProfileByNameParams{
+			Context:    ctx,
+			CustomerID: r.cr.Spec.ForProvider.CustomerID,
+			Name:       name,
+		})
+		if err != nil {
+			return errors.Wrap(err, errGetProfileFailed)
+		}
+		profileID = profile.ID
+	}
+
+	r.cr.Status.AtProvider.ZpnIsolationProfileName = name
+	r.cr.Status.AtProvider.ZpnIsolationProfileID = profileID
+	return nil
+}
+
+// generateIsolationPolicyRule generates the models.PolicyRule that is sent to
+// the ZPA API for the supplied IsolationPolicyRule and the ID of its
+// isolation profile.
+func generateIsolationPolicyRule(cr *v1alpha1.IsolationPolicyRule, profileID string) *models.PolicyRule {
+	p := cr.Spec.ForProvider
+
+	return &models.PolicyRule{
+		Name:            zpaclient.String(cr.Name),
+		Action:          p.Action,
+		ZpnCbiProfileID: profileID,
+		Description:     p.Description,
+		Operator:        p.Operator,
+		Conditions:      policyrule.GenerateConditions(p.Conditions),
+	}
+}
+
+// generateObservation generates observation for the input object models.PolicyRule
+func generateObservation(obj *models.PolicyRule) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime: obj.CreationTime,
+		ID:           obj.ID,
+		ModifiedBy:   obj.ModifiedBy,
+		ModifiedTime: obj.ModifiedTime,
+		PolicySetID:  obj.PolicySetID,
+		Priority:     obj.Priority,
+		RuleOrder:    obj.RuleOrder,
+
+		ZpnCbiProfileID: obj.ZpnCbiProfileID,
+	}
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+// The isolation profile is compared by the ID its name was last looked up as.
+func isUpToDate(cr *v1alpha1.IsolationPolicyRuleParameters, profileID string, obj *models.PolicyRule) bool {
+	if cr.RuleOrder != nil && *cr.RuleOrder != obj.RuleOrder {
+		return false
+	}
+
+	if !policyrule.IsEqualConditions(cr.Conditions, obj.Conditions) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Action, obj.Action},
+		{profileID, obj.ZpnCbiProfileID},
+		{cr.Description, obj.Description},
+		{cr.Operator, obj.Operator},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
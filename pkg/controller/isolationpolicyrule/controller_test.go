@@ -0,0 +1,461 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isolationpolicyrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/isolationpolicyrule/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID  = "72058304855015424"
+	policySetID = "72058304855000002"
+	id          = "72058304855015574"
+	appID       = "72058304855015500"
+	profileID   = "72058304855015580"
+	profileName = "example-isolation-profile"
+
+	// staleProfileID is the ID of an isolation profile that was recreated
+	// under the same name.
+	staleProfileID = "72058304855015581"
+)
+
+var errBoom = errors.New("boom")
+
+type isolationPolicyRuleModifier func(*v1alpha1.IsolationPolicyRule)
+
+func withSpec(p v1alpha1.IsolationPolicyRuleParameters) isolationPolicyRuleModifier {
+	return func(cr *v1alpha1.IsolationPolicyRule) { cr.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.Observation) isolationPolicyRuleModifier {
+	return func(cr *v1alpha1.IsolationPolicyRule) { cr.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() isolationPolicyRuleModifier {
+	return func(cr *v1alpha1.IsolationPolicyRule) {
+		cr.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1633046400, 0)})
+	}
+}
+
+func isolationPolicyRule(m ...isolationPolicyRuleModifier) *v1alpha1.IsolationPolicyRule {
+	cr := &v1alpha1.IsolationPolicyRule{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.IsolationPolicyRuleParameters)) v1alpha1.IsolationPolicyRuleParameters {
+	p := v1alpha1.IsolationPolicyRuleParameters{
+		CustomerID:              customerID,
+		Action:                  "ISOLATE",
+		ZpnIsolationProfileName: profileName,
+		Description:             "example",
+		Operator:                "AND",
+		RuleOrder:               zpaclient.Int32(2),
+		Conditions: []common.PolicyRuleCondition{
+			{
+				Operator: "OR",
+				Operands: []common.PolicyRuleOperand{
+					{ObjectType: common.ObjectTypeApp, RHS: zpaclient.String(appID)},
+				},
+			},
+			{
+				Negated:  true,
+				Operator: "OR",
+				Operands: []common.PolicyRuleOperand{
+					{ObjectType: common.ObjectTypeClientType, RHS: zpaclient.String("zpn_client_type_exporter")},
+					{ObjectType: common.ObjectTypeSAML, LHS: "72058304855015430", RHS: zpaclient.String("admin@example.com"), IdpID: "72058304855015420"},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.PolicyRule)) *models.PolicyRule {
+	p := model()
+	p.ID = id
+	p.PolicySetID = policySetID
+	p.Priority = 2
+	p.RuleOrder = 2
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	// The ZPA API returns the operands of a condition in any order and with
+	// their own IDs.
+	c := p.Conditions[1]
+	c.ID = "72058304855015440"
+	c.Operands[0], c.Operands[1] = c.Operands[1], c.Operands[0]
+	c.Operands[0].ID = "72058304855015441"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	PolicySetID:  policySetID,
+	Priority:     2,
+	RuleOrder:    2,
+
+	ZpnCbiProfileID:         profileID,
+	ZpnIsolationProfileName: profileName,
+	ZpnIsolationProfileID:   profileID,
+}
+
+// lookedUp is the status of an IsolationPolicyRule whose isolation profile was
+// looked up.
+var lookedUp = v1alpha1.Observation{
+	ZpnIsolationProfileName: profileName,
+	ZpnIsolationProfileID:   profileID,
+}
+
+// stale is the status of an IsolationPolicyRule whose isolation profile was
+// looked up before it was recreated.
+var stale = v1alpha1.Observation{
+	ZpnIsolationProfileName: profileName,
+	ZpnIsolationProfileID:   staleProfileID,
+}
+
+func profileParams() *lookup.GetIsolationProfileByNameParams {
+	return &lookup.GetIsolationProfileByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		Name:       profileName,
+	}
+}
+
+// foundProfile expects the isolation profile to be looked up by its name.
+func foundProfile(m *mocklookup.MockClientService) {
+	m.EXPECT().GetIsolationProfileByName(profileParams()).Return(&lookup.IsolationProfile{ID: profileID, Name: profileName}, nil)
+}
+
+func TestNewRule(t *testing.T) {
+	type want struct {
+		customerID        string
+		ruleOrder         *int32
+		observedRuleOrder int32
+		err               error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotIsolationPolicyRule": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotIsolationPolicyRule)},
+		},
+		"Success": {
+			mg:   isolationPolicyRule(withSpec(params()), withObservation(observation)),
+			want: want{customerID: customerID, ruleOrder: zpaclient.Int32(2), observedRuleOrder: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := ruleFn(nil)(tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nruleFn(...): -want error, +got error:\n%s\n", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.customerID, r.CustomerID()); diff != "" {
+				t.Errorf("\nr.CustomerID(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.ruleOrder, r.RuleOrder()); diff != "" {
+				t.Errorf("\nr.RuleOrder(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.observedRuleOrder, r.ObservedRuleOrder()); diff != "" {
+				t.Errorf("\nr.ObservedRuleOrder(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obj *models.PolicyRule
+		err error
+	}
+
+	noProfile := func(p *v1alpha1.IsolationPolicyRuleParameters) { p.ZpnIsolationProfileName = "" }
+
+	cases := map[string]struct {
+		lookup func(m *mocklookup.MockClientService)
+		mg     *v1alpha1.IsolationPolicyRule
+		want   want
+	}{
+		"Success": {
+			lookup: foundProfile,
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   want{cr: isolationPolicyRule(withSpec(params()), withObservation(lookedUp)), obj: model()},
+		},
+		// The isolation profile is looked up again, because it may have been
+		// recreated under the same name.
+		"ProfileRecreated": {
+			lookup: foundProfile,
+			mg:     isolationPolicyRule(withSpec(params()), withObservation(stale)),
+			want:   want{cr: isolationPolicyRule(withSpec(params()), withObservation(lookedUp)), obj: model()},
+		},
+		"NoProfile": {
+			mg: isolationPolicyRule(withSpec(params(noProfile)), withObservation(lookedUp)),
+			want: want{
+				cr: isolationPolicyRule(withSpec(params(noProfile))),
+				obj: func() *models.PolicyRule {
+					p := model()
+					p.ZpnCbiProfileID = ""
+					return p
+				}(),
+			},
+		},
+		"GetProfileFailed": {
+			lookup: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetIsolationProfileByName(profileParams()).Return(nil, errBoom)
+			},
+			mg:   isolationPolicyRule(withSpec(params())),
+			want: want{cr: isolationPolicyRule(withSpec(params())), err: errors.Wrap(errBoom, errGetProfileFailed)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			l := mocklookup.NewMockClientService(ctrl)
+			if tc.lookup != nil {
+				tc.lookup(l)
+			}
+			r, err := ruleFn(l)(tc.mg)
+			if err != nil {
+				t.Fatalf("ruleFn(...): %v", err)
+			}
+
+			got, err := r.Generate(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nr.Generate(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\nr.Generate(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, resource.Managed(tc.mg)); diff != "" {
+				t.Errorf("\nr.Generate(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr              resource.Managed
+		upToDate        bool
+		lateInitialized bool
+		err             error
+	}
+
+	observed := isolationPolicyRule(withSpec(params()), withObservation(observation))
+	drifted := want{cr: observed}
+	withStatus := func(f func(o *v1alpha1.Observation)) isolationPolicyRuleModifier {
+		o := observation
+		f(&o)
+		return withObservation(o)
+	}
+
+	cases := map[string]struct {
+		lookup func(m *mocklookup.MockClientService)
+		obj    *models.PolicyRule
+		mg     *v1alpha1.IsolationPolicyRule
+		want   want
+	}{
+		"UpToDate": {
+			lookup: foundProfile,
+			obj:    payload(),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   want{cr: observed, upToDate: true},
+		},
+		"LateInitialize": {
+			lookup: foundProfile,
+			obj:    payload(),
+			mg: isolationPolicyRule(withSpec(params(func(p *v1alpha1.IsolationPolicyRuleParameters) {
+				p.Operator = ""
+				p.RuleOrder = nil
+			}))),
+			want: want{cr: observed, upToDate: true, lateInitialized: true},
+		},
+		"ActionDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.Action = "BYPASS_ISOLATE" }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   drifted,
+		},
+		"ZpnCbiProfileIDDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.ZpnCbiProfileID = staleProfileID }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want: want{
+				cr: isolationPolicyRule(withSpec(params()), withStatus(func(o *v1alpha1.Observation) { o.ZpnCbiProfileID = staleProfileID })),
+			},
+		},
+		// A rule that still refers to an isolation profile that was recreated
+		// under the same name is not up to date, although the name of its
+		// isolation profile did not change.
+		"ProfileRecreated": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.ZpnCbiProfileID = staleProfileID }),
+			mg:     isolationPolicyRule(withSpec(params()), withObservation(stale)),
+			want: want{
+				cr: isolationPolicyRule(withSpec(params()), withStatus(func(o *v1alpha1.Observation) { o.ZpnCbiProfileID = staleProfileID })),
+			},
+		},
+		"GetProfileFailed": {
+			lookup: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetIsolationProfileByName(profileParams()).Return(nil, errBoom)
+			},
+			obj: payload(),
+			mg:  isolationPolicyRule(withSpec(params()), withObservation(stale)),
+			want: want{
+				cr:  isolationPolicyRule(withSpec(params()), withStatus(func(o *v1alpha1.Observation) { o.ZpnIsolationProfileID = staleProfileID })),
+				err: errors.Wrap(errBoom, errGetProfileFailed),
+			},
+		},
+		"ProfileNotLookedUpWhenDeleted": {
+			obj: payload(),
+			mg:  isolationPolicyRule(withSpec(params()), withDeletionTimestamp()),
+			want: want{
+				cr: isolationPolicyRule(withSpec(params()), withDeletionTimestamp(), withStatus(func(o *v1alpha1.Observation) {
+					o.ZpnIsolationProfileName = ""
+					o.ZpnIsolationProfileID = ""
+				})),
+			},
+		},
+		"DescriptionDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.Description = "changed" }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   drifted,
+		},
+		"OperatorDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.Operator = "OR" }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   drifted,
+		},
+		"RuleOrderDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.RuleOrder = 1 }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want: want{
+				cr: isolationPolicyRule(withSpec(params()), withStatus(func(o *v1alpha1.Observation) { o.RuleOrder = 1 })),
+			},
+		},
+		"ConditionDrift": {
+			lookup: foundProfile,
+			obj:    payload(func(p *models.PolicyRule) { p.Conditions[0].Operands[0].RHS = "72058304855015501" }),
+			mg:     isolationPolicyRule(withSpec(params())),
+			want:   drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			l := mocklookup.NewMockClientService(ctrl)
+			if tc.lookup != nil {
+				tc.lookup(l)
+			}
+			r, err := ruleFn(l)(tc.mg)
+			if err != nil {
+				t.Fatalf("ruleFn(...): %v", err)
+			}
+
+			upToDate, lateInitialized, err := r.Observe(context.Background(), tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nr.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("\nr.Observe(...): -want up to date, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.lateInitialized, lateInitialized); diff != "" {
+				t.Errorf("\nr.Observe(...): -want late initialized, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, resource.Managed(tc.mg)); diff != "" {
+				t.Errorf("\nr.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// model returns the PolicyRule that is sent to the ZPA API for the parameters
+// returned by params.
+func model() *models.PolicyRule {
+	return &models.PolicyRule{
+		Name:            zpaclient.String("example"),
+		Action:          "ISOLATE",
+		ZpnCbiProfileID: profileID,
+		Description:     "example",
+		Operator:        "AND",
+		Conditions: []*models.ConditionSet{
+			{
+				Operator: "OR",
+				Operands: []*models.Operand{
+					{ObjectType: "APP", LHS: "id", RHS: appID},
+				},
+			},
+			{
+				Negated:  true,
+				Operator: "OR",
+				Operands: []*models.Operand{
+					{ObjectType: "CLIENT_TYPE", LHS: "id", RHS: "zpn_client_type_exporter"},
+					{ObjectType: "SAML", LHS: "72058304855015430", RHS: "admin@example.com", IdpID: "72058304855015420"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lssconfig
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/lssconfig/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lssconfig"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+)
+
+const (
+	errNotLSSConfig   = "managed resource is not an LSSConfig custom resource"
+	errCreateFailed   = "cannot create LSSConfig"
+	errUpdateFailed   = "cannot update LSSConfig"
+	errDescribeFailed = "cannot describe LSSConfig"
+	errDeleteFailed   = "cannot delete LSSConfig"
+)
+
+// SetupLSSConfig adds a controller that reconciles LSSConfigs.
+func SetupLSSConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.LSSConfigKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.LSSConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LSSConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: lssconfig.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) lssconfig.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client lssconfig.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.LSSConfig)
+	if !ok {
+		return nil, errors.New(errNotLSSConfig)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LSSConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLSSConfig)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &lssconfig.GetLSSConfigParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		LSSID:      id,
+	}
+	obj, reqErr := e.client.GetLSSConfig(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	e.LateInitialize(cr, obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(&cr.Spec.ForProvider, obj),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LSSConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLSSConfig)
+	}
+
+	req := &lssconfig.AddLSSConfigParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		LSSConfig:  generateLSSConfig(cr),
+	}
+
+	obj, err := e.client.AddLSSConfig(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LSSConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLSSConfig)
+	}
+
+	req := &lssconfig.UpdateLSSConfigParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		LSSID:      meta.GetExternalName(cr),
+		LSSConfig:  generateLSSConfig(cr),
+	}
+
+	if err := e.client.UpdateLSSConfig(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LSSConfig)
+	if !ok {
+		return errors.New(errNotLSSConfig)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotLSSConfig)
+	}
+
+	req := &lssconfig.DeleteLSSConfigParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		LSSID:      id,
+	}
+
+	if err := e.client.DeleteLSSConfig(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+func (e *external) LateInitialize(cr *v1alpha1.LSSConfig, obj *lssconfig.LSSConfig) {
+	p := &cr.Spec.ForProvider
+
+	if p.Enabled == nil {
+		p.Enabled = zpaclient.Bool(obj.Config.Enabled)
+	}
+
+	if p.UseTLS == nil {
+		p.UseTLS = zpaclient.Bool(obj.Config.UseTLS)
+	}
+
+	if len(p.Filter) == 0 && len(obj.Config.Filter) > 0 {
+		p.Filter = obj.Config.Filter
+	}
+}
+
+// generateLSSConfig generates the lssconfig.LSSConfig that is sent to the
+// ZPA API for the supplied LSSConfig. Its policy rule logs everything unless
+// conditions are given. Once observed, the policy rule is sent with its ID,
+// because the ZPA API does not update a policy rule without one.
+func generateLSSConfig(cr *v1alpha1.LSSConfig) *lssconfig.LSSConfig {
+	p := cr.Spec.ForProvider
+
+	return &lssconfig.LSSConfig{
+		Config: lssconfig.Config{
+			Name:          cr.Name,
+			Description:   p.Description,
+			Enabled:       zpaclient.BoolValue(p.Enabled),
+			LSSHost:       p.LSSHost,
+			LSSPort:       p.LSSPort,
+			Format:        p.Format,
+			SourceLogType: p.SourceLogType,
+			UseTLS:        zpaclient.BoolValue(p.UseTLS),
+			Filter:        p.Filter,
+		},
+		ConnectorGroups: connectorGroups(p.AppConnectorGroups),
+		PolicyRuleResource: &models.PolicyRule{
+			ID:         cr.Status.AtProvider.PolicyRuleID,
+			Name:       zpaclient.String(cr.Name),
+			Action:     lssconfig.ActionLog,
+			Conditions: policyrule.GenerateConditions(p.Conditions),
+		},
+	}
+}
+
+// connectorGroups returns the app connector groups with the supplied IDs.
+func connectorGroups(ids []string) []lssconfig.ConnectorGroup {
+	out := make([]lssconfig.ConnectorGroup, len(ids))
+	for i, id := range ids {
+		out[i] = lssconfig.ConnectorGroup{ID: id}
+	}
+	return out
+}
+
+// connectorGroupIDs returns the IDs of the supplied app connector groups.
+func connectorGroupIDs(in []lssconfig.ConnectorGroup) []string {
+	out := make([]string, len(in))
+	for i, g := range in {
+		out[i] = g.ID
+	}
+	return out
+}
+
+// generateObservation generates observation for the input object lssconfig.LSSConfig
+func generateObservation(obj *lssconfig.LSSConfig) v1alpha1.Observation {
+	o := v1alpha1.Observation{
+		CreationTime: obj.Config.CreationTime,
+		ID:           obj.ID,
+		ModifiedBy:   obj.Config.ModifiedBy,
+		ModifiedTime: obj.Config.ModifiedTime,
+	}
+	if obj.PolicyRuleResource != nil {
+		o.PolicyRuleID = obj.PolicyRuleResource.ID
+	}
+	return o
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.LSSConfigParameters, obj *lssconfig.LSSConfig) bool { // nolint:gocyclo
+	if !zpaclient.IsEqualBool(cr.Enabled, zpaclient.Bool(obj.Config.Enabled)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualBool(cr.UseTLS, zpaclient.Bool(obj.Config.UseTLS)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualStringArrayContent(cr.Filter, obj.Config.Filter) {
+		return false
+	}
+
+	if !zpaclient.IsEqualStringArrayContent(cr.AppConnectorGroups, connectorGroupIDs(obj.ConnectorGroups)) {
+		return false
+	}
+
+	var conditions []*models.ConditionSet
+	if obj.PolicyRuleResource != nil {
+		conditions = obj.PolicyRuleResource.Conditions
+	}
+	if !policyrule.IsEqualConditions(cr.Conditions, conditions) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Description, obj.Config.Description},
+		{cr.SourceLogType, obj.Config.SourceLogType},
+		{cr.LSSHost, obj.Config.LSSHost},
+		{cr.LSSPort, obj.Config.LSSPort},
+		{cr.Format, obj.Config.Format},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
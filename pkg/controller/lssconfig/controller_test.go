@@ -0,0 +1,627 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lssconfig
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	common "github.com/crossplane-contrib/provider-zpa/apis/common/v1alpha1"
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/lssconfig/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lssconfig"
+	mocklssconfig "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lssconfig"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+)
+
+const (
+	customerID   = "72058304855015424"
+	id           = "72058304855015574"
+	policyRuleID = "72058304855015575"
+	appID        = "72058304855015500"
+	format       = `{"LogTimestamp": %j{LogTimestamp:time},"Customer": %j{Customer},"Username": %j{Username}}\n`
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type lssConfigModifier func(*v1alpha1.LSSConfig)
+
+func withExternalName(n string) lssConfigModifier {
+	return func(cr *v1alpha1.LSSConfig) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.LSSConfigParameters) lssConfigModifier {
+	return func(cr *v1alpha1.LSSConfig) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) lssConfigModifier {
+	return func(cr *v1alpha1.LSSConfig) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) lssConfigModifier {
+	return func(cr *v1alpha1.LSSConfig) { cr.Status.AtProvider = o }
+}
+
+func lssConfig(m ...lssConfigModifier) *v1alpha1.LSSConfig {
+	cr := &v1alpha1.LSSConfig{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.LSSConfigParameters)) v1alpha1.LSSConfigParameters {
+	p := v1alpha1.LSSConfigParameters{
+		CustomerID:         customerID,
+		Enabled:            zpaclient.Bool(true),
+		Description:        "example",
+		SourceLogType:      "zpn_trans_log",
+		LSSHost:            "siem.example.com",
+		LSSPort:            "11000",
+		Format:             format,
+		Filter:             []string{"ZPN_STATUS_AUTH_FAILED", "BRK_MT_SETUP_FAIL_SAML_EXPIRED"},
+		UseTLS:             zpaclient.Bool(true),
+		AppConnectorGroups: []string{"72058304855015450", "72058304855015451"},
+		Conditions: []common.PolicyRuleCondition{
+			{
+				Operator: "OR",
+				Operands: []common.PolicyRuleOperand{
+					{ObjectType: common.ObjectTypeApp, RHS: zpaclient.String(appID)},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*lssconfig.LSSConfig)) *lssconfig.LSSConfig {
+	p := model()
+	p.ID = id
+	p.Config.CreationTime = "1633046400"
+	p.Config.ModifiedBy = "admin"
+	p.Config.ModifiedTime = "1633050000"
+	p.ConnectorGroups[0].Name = "example"
+	p.PolicyRuleResource.ID = policyRuleID
+	p.PolicyRuleResource.Conditions[0].ID = "72058304855015440"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	PolicyRuleID: policyRuleID,
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotLSSConfig": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotLSSConfig)},
+		},
+		"NoProviderConfigRef": {
+			mg:   lssConfig(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := lssConfig()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) lssconfig.ClientService {
+					called = true
+					return lssconfig.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*lssconfig.LSSConfig)) func(m *mocklssconfig.MockClientService) {
+		return func(m *mocklssconfig.MockClientService) {
+			m.EXPECT().GetLSSConfig(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := lssConfig(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mocklssconfig.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotLSSConfig": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotLSSConfig)},
+		},
+		"NoExternalName": {
+			mg:   lssConfig(withSpec(params())),
+			want: want{cr: lssConfig(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().GetLSSConfig(getParams()).Return(nil, errNotFound)
+			},
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: want{cr: lssConfig(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().GetLSSConfig(getParams()).Return(nil, errBoom)
+			},
+			mg: lssConfig(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  lssConfig(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*lssconfig.LSSConfig) {}),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"UpToDateInAnyOrder": {
+			mock: drift(func(p *lssconfig.LSSConfig) {
+				p.Config.Filter[0], p.Config.Filter[1] = p.Config.Filter[1], p.Config.Filter[0]
+				p.ConnectorGroups[0], p.ConnectorGroups[1] = p.ConnectorGroups[1], p.ConnectorGroups[0]
+			}),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*lssconfig.LSSConfig) {}),
+			mg: lssConfig(withExternalName(id), withSpec(params(func(p *v1alpha1.LSSConfigParameters) {
+				p.Enabled = nil
+				p.UseTLS = nil
+				p.Filter = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.Enabled = false }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.Description = "changed" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"SourceLogTypeDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.SourceLogType = "zpn_auth_log" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LSSHostDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.LSSHost = "10.0.0.10" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LSSPortDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.LSSPort = "11001" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"FormatDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.Format = "%s{LogTimestamp}\\n" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"FilterDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.Filter = p.Config.Filter[:1] }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UseTLSDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.Config.UseTLS = false }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ConnectorGroupsDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.ConnectorGroups = p.ConnectorGroups[:1] }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ConditionDrift": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.PolicyRuleResource.Conditions[0].Operands[0].RHS = "72058304855015501" }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"PolicyRuleRemoved": {
+			mock: drift(func(p *lssconfig.LSSConfig) { p.PolicyRuleResource = nil }),
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: want{
+				cr: lssConfig(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(func() v1alpha1.Observation {
+					o := observation
+					o.PolicyRuleID = ""
+					return o
+				}())),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklssconfig.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklssconfig.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotLSSConfig": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotLSSConfig)},
+		},
+		"Success": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().AddLSSConfig(&lssconfig.AddLSSConfigParams{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					LSSConfig:  model(),
+				}).Return(&lssconfig.LSSConfig{ID: id}, nil)
+			},
+			mg: lssConfig(withSpec(params())),
+			want: want{
+				cr:  lssConfig(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().AddLSSConfig(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: lssConfig(withSpec(params())),
+			want: want{
+				cr:  lssConfig(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklssconfig.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mocklssconfig.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotLSSConfig": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotLSSConfig),
+		},
+		"Success": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().UpdateLSSConfig(&lssconfig.UpdateLSSConfigParams{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					LSSID:      id,
+					LSSConfig: func() *lssconfig.LSSConfig {
+						p := model()
+						p.PolicyRuleResource.ID = policyRuleID
+						return p
+					}(),
+				}).Return(nil)
+			},
+			mg: lssConfig(withExternalName(id), withSpec(params()), withObservation(observation)),
+		},
+		"UpdateFailed": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().UpdateLSSConfig(gomock.Any()).Return(errBoom)
+			},
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklssconfig.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mocklssconfig.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotLSSConfig": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotLSSConfig),
+		},
+		"NoExternalName": {
+			mg:   lssConfig(withSpec(params())),
+			want: errors.New(errNotLSSConfig),
+		},
+		"Success": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().DeleteLSSConfig(&lssconfig.DeleteLSSConfigParams{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					LSSID:      id,
+				}).Return(nil)
+			},
+			mg: lssConfig(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().DeleteLSSConfig(gomock.Any()).Return(errNotFound)
+			},
+			mg: lssConfig(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mocklssconfig.MockClientService) {
+				m.EXPECT().DeleteLSSConfig(gomock.Any()).Return(errBoom)
+			},
+			mg:   lssConfig(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklssconfig.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *lssconfig.GetLSSConfigParams {
+	return &lssconfig.GetLSSConfigParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		LSSID:      id,
+	}
+}
+
+// model returns the LSSConfig that is sent to the ZPA API for the parameters
+// returned by params.
+func model() *lssconfig.LSSConfig {
+	return &lssconfig.LSSConfig{
+		Config: lssconfig.Config{
+			Name:          "example",
+			Description:   "example",
+			Enabled:       true,
+			LSSHost:       "siem.example.com",
+			LSSPort:       "11000",
+			Format:        format,
+			SourceLogType: "zpn_trans_log",
+			UseTLS:        true,
+			Filter:        []string{"ZPN_STATUS_AUTH_FAILED", "BRK_MT_SETUP_FAIL_SAML_EXPIRED"},
+		},
+		ConnectorGroups: []lssconfig.ConnectorGroup{
+			{ID: "72058304855015450"},
+			{ID: "72058304855015451"},
+		},
+		PolicyRuleResource: &models.PolicyRule{
+			Name:   zpaclient.String("example"),
+			Action: "LOG",
+			Conditions: []*models.ConditionSet{
+				{
+					Operator: "OR",
+					Operands: []*models.Operand{
+						{ObjectType: "APP", LHS: "id", RHS: appID},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: lssconfig.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.LSSConfig{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = params()
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "lssConfig", externalName); !ok || got["config"].(map[string]interface{})["name"] != "example" {
+		t.Errorf("e.Create(...): want LSS configuration %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.LSSPort = "11001"
+	cr.Spec.ForProvider.Conditions = append(cr.Spec.ForProvider.Conditions, common.PolicyRuleCondition{
+		Operator: "OR",
+		Operands: []common.PolicyRuleOperand{{ObjectType: common.ObjectTypeClientType, RHS: zpaclient.String("zpn_client_type_exporter")}},
+	})
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want LSS configuration to be up to date after update, got %+v, %v", obs, err)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinegroup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/machinegroup/v1alpha1"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+)
+
+const (
+	errNotMachineGroup    = "managed resource is not a MachineGroup custom resource"
+	errCreateNotSupported = "machine groups are generated by the tenant and cannot be created, check spec.forProvider.name"
+)
+
+// SetupMachineGroup adds a controller that reconciles MachineGroups.
+func SetupMachineGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.MachineGroupKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.MachineGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MachineGroupGroupVersionKind),
+			managed.WithExternalConnecter(&lookup.Connector{
+				Kube:               mgr.GetClient(),
+				NewClientFn:        lookup.New,
+				Logger:             logger,
+				Recorder:           recorder,
+				Kind:               v1alpha1.MachineGroupKind,
+				CreateNotSupported: errCreateNotSupported,
+				Lookup:             lookupMachineGroup,
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// lookupMachineGroup looks up the machine group of the supplied MachineGroup by its name.
+// It returns the ID of the machine group.
+func lookupMachineGroup(ctx context.Context, client lookup.ClientService, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*v1alpha1.MachineGroup)
+	if !ok {
+		return "", errors.New(errNotMachineGroup)
+	}
+
+	obj, err := client.GetMachineGroupByName(&lookup.GetMachineGroupByNameParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		Name:       cr.Spec.ForProvider.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	return obj.ID, nil
+}
+
+// generateObservation generates observation for the input object models.MachineGroup
+func generateObservation(obj *models.MachineGroup) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime: obj.CreationTime,
+		ID:           obj.ID,
+		ModifiedBy:   obj.ModifiedBy,
+		ModifiedTime: obj.ModifiedTime,
+		Description:  obj.Description,
+		Enabled:      obj.Enabled,
+		Machines:     machineNames(obj.Machines),
+	}
+}
+
+// machineNames returns the names of the supplied machines.
+func machineNames(in []*models.Machine) []string {
+	var out []string
+	for _, m := range in {
+		if m != nil && m.Name != nil {
+			out = append(out, *m.Name)
+		}
+	}
+	return out
+}
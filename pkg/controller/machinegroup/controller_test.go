@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/machinegroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID       = "72058304855015424"
+	id               = "72058304855015574"
+	machineGroupName = "Corp-Windows-Laptops"
+)
+
+type machineGroupModifier func(*v1alpha1.MachineGroup)
+
+func withObservation(o v1alpha1.Observation) machineGroupModifier {
+	return func(cr *v1alpha1.MachineGroup) { cr.Status.AtProvider = o }
+}
+
+func machineGroup(m ...machineGroupModifier) *v1alpha1.MachineGroup {
+	cr := &v1alpha1.MachineGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.MachineGroupParameters{CustomerID: customerID, Name: machineGroupName}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func payload() *models.MachineGroup {
+	return &models.MachineGroup{
+		ID:          id,
+		Name:        zpaclient.String(machineGroupName),
+		Description: "Windows laptops of employees",
+		Enabled:     true,
+		Machines: []*models.Machine{
+			{ID: "72058304855015600", Name: zpaclient.String("LAPTOP-0001")},
+			{ID: "72058304855015601", Name: zpaclient.String("LAPTOP-0002")},
+		},
+		CreationTime: "1633046400",
+		ModifiedBy:   "admin",
+		ModifiedTime: "1633050000",
+	}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	Description:  "Windows laptops of employees",
+	Enabled:      true,
+	Machines:     []string{"LAPTOP-0001", "LAPTOP-0002"},
+}
+
+func TestLookupMachineGroup(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		id  string
+		err error
+	}
+
+	getParams := &lookup.GetMachineGroupByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		Name:       machineGroupName,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklookup.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotMachineGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotMachineGroup)},
+		},
+		"Found": {
+			mock: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetMachineGroupByName(getParams).Return(payload(), nil)
+			},
+			mg:   machineGroup(),
+			want: want{cr: machineGroup(withObservation(observation)), id: id},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklookup.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+
+			id, err := lookupMachineGroup(context.Background(), m, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nlookupMachineGroup(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\nlookupMachineGroup(...): -want ID, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\nlookupMachineGroup(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
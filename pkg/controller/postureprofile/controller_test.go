@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postureprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/postureprofile/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID         = "72058304855015424"
+	id                 = "72058304855015574"
+	postureProfileName = "CrowdStrike_ZPA_Pre-ZTA"
+	postureUdid        = "a1b2c3d4-e5f6-7a8b-9c0d-e1f2a3b4c5d6"
+)
+
+type postureProfileModifier func(*v1alpha1.PostureProfile)
+
+func withObservation(o v1alpha1.Observation) postureProfileModifier {
+	return func(cr *v1alpha1.PostureProfile) { cr.Status.AtProvider = o }
+}
+
+func postureProfile(m ...postureProfileModifier) *v1alpha1.PostureProfile {
+	cr := &v1alpha1.PostureProfile{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.PostureProfileParameters{CustomerID: customerID, Name: postureProfileName}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func payload() *models.PostureProfile {
+	return &models.PostureProfile{
+		ID:           id,
+		Name:         zpaclient.String(postureProfileName),
+		PostureUdid:  postureUdid,
+		ZscalerCloud: "zscalerthree",
+		CreationTime: "1633046400",
+		ModifiedBy:   "admin",
+		ModifiedTime: "1633050000",
+	}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	PostureUdid:  postureUdid,
+	ZscalerCloud: "zscalerthree",
+}
+
+func TestLookupPostureProfile(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		id  string
+		err error
+	}
+
+	getParams := &lookup.GetPostureProfileByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		Name:       postureProfileName,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklookup.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotPostureProfile": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotPostureProfile)},
+		},
+		"Found": {
+			mock: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetPostureProfileByName(getParams).Return(payload(), nil)
+			},
+			mg:   postureProfile(),
+			want: want{cr: postureProfile(withObservation(observation)), id: id},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklookup.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+
+			id, err := lookupPostureProfile(context.Background(), m, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nlookupPostureProfile(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\nlookupPostureProfile(...): -want ID, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\nlookupPostureProfile(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioningkey
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/provisioningkey/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/provisioningkey"
+)
+
+const (
+	errNotProvisioningKey = "managed resource is not a ProvisioningKey custom resource"
+	errCreateFailed       = "cannot create ProvisioningKey"
+	errUpdateFailed       = "cannot update ProvisioningKey"
+	errDescribeFailed     = "cannot describe ProvisioningKey"
+	errDeleteFailed       = "cannot delete ProvisioningKey"
+)
+
+// SetupProvisioningKey adds a controller that reconciles ProvisioningKeys.
+func SetupProvisioningKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.ProvisioningKeyKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ProvisioningKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProvisioningKeyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: provisioningkey.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) provisioningkey.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client provisioningkey.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.ProvisioningKey)
+	if !ok {
+		return nil, errors.New(errNotProvisioningKey)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProvisioningKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProvisioningKey)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &provisioningkey.GetProvisioningKeyParams{
+		Context:           ctx,
+		CustomerID:        cr.Spec.ForProvider.CustomerID,
+		AssociationType:   cr.Spec.ForProvider.AssociationType,
+		ProvisioningKeyID: id,
+	}
+	obj, reqErr := e.client.GetProvisioningKey(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	e.LateInitialize(cr, obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(&cr.Spec.ForProvider, obj),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+		ConnectionDetails:       connectionDetails(obj),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProvisioningKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProvisioningKey)
+	}
+
+	req := &provisioningkey.AddProvisioningKeyParams{
+		Context:         ctx,
+		CustomerID:      cr.Spec.ForProvider.CustomerID,
+		AssociationType: cr.Spec.ForProvider.AssociationType,
+		ProvisioningKey: generateProvisioningKey(cr),
+	}
+
+	obj, err := e.client.AddProvisioningKey(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    connectionDetails(obj),
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProvisioningKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProvisioningKey)
+	}
+
+	req := &provisioningkey.UpdateProvisioningKeyParams{
+		Context:           ctx,
+		CustomerID:        cr.Spec.ForProvider.CustomerID,
+		AssociationType:   cr.Spec.ForProvider.AssociationType,
+		ProvisioningKeyID: meta.GetExternalName(cr),
+		ProvisioningKey:   generateProvisioningKey(cr),
+	}
+
+	if err := e.client.UpdateProvisioningKey(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProvisioningKey)
+	if !ok {
+		return errors.New(errNotProvisioningKey)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotProvisioningKey)
+	}
+
+	req := &provisioningkey.DeleteProvisioningKeyParams{
+		Context:           ctx,
+		CustomerID:        cr.Spec.ForProvider.CustomerID,
+		AssociationType:   cr.Spec.ForProvider.AssociationType,
+		ProvisioningKeyID: id,
+	}
+
+	if err := e.client.DeleteProvisioningKey(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+func (e *external) LateInitialize(cr *v1alpha1.ProvisioningKey, obj *provisioningkey.ProvisioningKey) {
+	if cr.Spec.ForProvider.Enabled == nil {
+		cr.Spec.ForProvider.Enabled = zpaclient.Bool(obj.Enabled)
+	}
+	if cr.Spec.ForProvider.EnrollmentCertID == "" {
+		cr.Spec.ForProvider.EnrollmentCertID = obj.EnrollmentCertID
+	}
+}
+
+// generateProvisioningKey generates the provisioningkey.ProvisioningKey that
+// is sent to the ZPA API for the supplied ProvisioningKey.
+func generateProvisioningKey(cr *v1alpha1.ProvisioningKey) *provisioningkey.ProvisioningKey {
+	return &provisioningkey.ProvisioningKey{
+		Name:             cr.Name,
+		Enabled:          zpaclient.BoolValue(cr.Spec.ForProvider.Enabled),
+		MaxUsage:         cr.Spec.ForProvider.MaxUsage,
+		EnrollmentCertID: cr.Spec.ForProvider.EnrollmentCertID,
+		ZComponentID:     zpaclient.StringValue(cr.Spec.ForProvider.ZComponentID),
+	}
+}
+
+// connectionDetails returns the provisioning key, which App Connectors and
+// Service Edges need to enroll.
+func connectionDetails(obj *provisioningkey.ProvisioningKey) managed.ConnectionDetails {
+	if obj.ProvisioningKey == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.ConnectionSecretProvisioningKeyKey: []byte(obj.ProvisioningKey),
+	}
+}
+
+// generateObservation generates observation for the input object provisioningkey.ProvisioningKey
+func generateObservation(obj *provisioningkey.ProvisioningKey) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime:         obj.CreationTime,
+		ID:                   obj.ID,
+		ModifiedBy:           obj.ModifiedBy,
+		ModifiedTime:         obj.ModifiedTime,
+		UsageCount:           obj.UsageCount,
+		ExpirationInEpochSec: obj.ExpirationInEpochSec,
+		EnrollmentCertName:   obj.EnrollmentCertName,
+		ZComponentName:       obj.ZComponentName,
+	}
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.ProvisioningKeyParameters, obj *provisioningkey.ProvisioningKey) bool {
+	if !zpaclient.IsEqualBool(cr.Enabled, zpaclient.Bool(obj.Enabled)) {
+		return false
+	}
+
+	if cr.MaxUsage != obj.MaxUsage {
+		return false
+	}
+
+	if cr.EnrollmentCertID != obj.EnrollmentCertID {
+		return false
+	}
+
+	if !zpaclient.IsEqualString(cr.ZComponentID, zpaclient.StringToPtr(obj.ZComponentID)) {
+		return false
+	}
+
+	return true
+}
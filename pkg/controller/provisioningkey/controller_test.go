@@ -0,0 +1,549 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioningkey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/provisioningkey/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockpk "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/provisioningkey"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/provisioningkey"
+)
+
+const (
+	customerID          = "72058304855015424"
+	id                  = "72058304855015574"
+	appConnectorGroupID = "72058304855015500"
+	enrollmentCertID    = "2519"
+	key                 = "3|api.private.zscaler.com|example"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type provisioningKeyModifier func(*v1alpha1.ProvisioningKey)
+
+func withExternalName(n string) provisioningKeyModifier {
+	return func(cr *v1alpha1.ProvisioningKey) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ProvisioningKeyParameters) provisioningKeyModifier {
+	return func(cr *v1alpha1.ProvisioningKey) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) provisioningKeyModifier {
+	return func(cr *v1alpha1.ProvisioningKey) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) provisioningKeyModifier {
+	return func(cr *v1alpha1.ProvisioningKey) { cr.Status.AtProvider = o }
+}
+
+func provisioningKey(m ...provisioningKeyModifier) *v1alpha1.ProvisioningKey {
+	cr := &v1alpha1.ProvisioningKey{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ProvisioningKeyParameters)) v1alpha1.ProvisioningKeyParameters {
+	p := v1alpha1.ProvisioningKeyParameters{
+		CustomerID:       customerID,
+		AssociationType:  provisioningkey.AssociationTypeConnectorGroup,
+		Enabled:          zpaclient.Bool(true),
+		MaxUsage:         "10",
+		EnrollmentCertID: enrollmentCertID,
+		ZComponentID:     zpaclient.String(appConnectorGroupID),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*provisioningkey.ProvisioningKey)) *provisioningkey.ProvisioningKey {
+	p := model()
+	p.ID = id
+	p.ProvisioningKey = key
+	p.UsageCount = "2"
+	p.EnrollmentCertName = "Connector"
+	p.ZComponentName = "example"
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                 id,
+	CreationTime:       "1633046400",
+	ModifiedBy:         "admin",
+	ModifiedTime:       "1633050000",
+	UsageCount:         "2",
+	EnrollmentCertName: "Connector",
+	ZComponentName:     "example",
+}
+
+var connection = managed.ConnectionDetails{v1alpha1.ConnectionSecretProvisioningKeyKey: []byte(key)}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotProvisioningKey": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotProvisioningKey)},
+		},
+		"NoProviderConfigRef": {
+			mg:   provisioningKey(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := provisioningKey()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) provisioningkey.ClientService {
+					called = true
+					return provisioningkey.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*provisioningkey.ProvisioningKey)) func(m *mockpk.MockClientService) {
+		return func(m *mockpk.MockClientService) {
+			m.EXPECT().GetProvisioningKey(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := provisioningKey(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connection}}
+
+	cases := map[string]struct {
+		mock func(m *mockpk.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotProvisioningKey": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotProvisioningKey)},
+		},
+		"NoExternalName": {
+			mg:   provisioningKey(withSpec(params())),
+			want: want{cr: provisioningKey(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().GetProvisioningKey(getParams()).Return(nil, errNotFound)
+			},
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: want{cr: provisioningKey(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().GetProvisioningKey(getParams()).Return(nil, errBoom)
+			},
+			mg: provisioningKey(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  provisioningKey(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*provisioningkey.ProvisioningKey) {}),
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connection}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*provisioningkey.ProvisioningKey) {}),
+			mg: provisioningKey(withExternalName(id), withSpec(params(func(p *v1alpha1.ProvisioningKeyParameters) {
+				p.Enabled = nil
+				p.EnrollmentCertID = ""
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: connection}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *provisioningkey.ProvisioningKey) { p.Enabled = false }),
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"MaxUsageDrift": {
+			mock: drift(func(p *provisioningkey.ProvisioningKey) { p.MaxUsage = "20" }),
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"EnrollmentCertIDDrift": {
+			mock: drift(func(p *provisioningkey.ProvisioningKey) { p.EnrollmentCertID = "2520" }),
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ZComponentIDDrift": {
+			mock: drift(func(p *provisioningkey.ProvisioningKey) { p.ZComponentID = "72058304855015501" }),
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpk.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockpk.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotProvisioningKey": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotProvisioningKey)},
+		},
+		"Success": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().AddProvisioningKey(&provisioningkey.AddProvisioningKeyParams{
+					Context:         context.Background(),
+					CustomerID:      customerID,
+					AssociationType: provisioningkey.AssociationTypeConnectorGroup,
+					ProvisioningKey: model(),
+				}).Return(&provisioningkey.ProvisioningKey{ID: id, ProvisioningKey: key}, nil)
+			},
+			mg: provisioningKey(withSpec(params())),
+			want: want{
+				cr:  provisioningKey(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connection},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().AddProvisioningKey(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: provisioningKey(withSpec(params())),
+			want: want{
+				cr:  provisioningKey(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpk.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockpk.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotProvisioningKey": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotProvisioningKey),
+		},
+		"Success": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().UpdateProvisioningKey(&provisioningkey.UpdateProvisioningKeyParams{
+					Context:           context.Background(),
+					CustomerID:        customerID,
+					AssociationType:   provisioningkey.AssociationTypeConnectorGroup,
+					ProvisioningKeyID: id,
+					ProvisioningKey:   model(),
+				}).Return(nil)
+			},
+			mg: provisioningKey(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().UpdateProvisioningKey(gomock.Any()).Return(errBoom)
+			},
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpk.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockpk.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotProvisioningKey": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotProvisioningKey),
+		},
+		"NoExternalName": {
+			mg:   provisioningKey(withSpec(params())),
+			want: errors.New(errNotProvisioningKey),
+		},
+		"Success": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().DeleteProvisioningKey(&provisioningkey.DeleteProvisioningKeyParams{
+					Context:           context.Background(),
+					CustomerID:        customerID,
+					AssociationType:   provisioningkey.AssociationTypeConnectorGroup,
+					ProvisioningKeyID: id,
+				}).Return(nil)
+			},
+			mg: provisioningKey(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().DeleteProvisioningKey(gomock.Any()).Return(errNotFound)
+			},
+			mg: provisioningKey(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockpk.MockClientService) {
+				m.EXPECT().DeleteProvisioningKey(gomock.Any()).Return(errBoom)
+			},
+			mg:   provisioningKey(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockpk.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *provisioningkey.GetProvisioningKeyParams {
+	return &provisioningkey.GetProvisioningKeyParams{
+		Context:           context.Background(),
+		CustomerID:        customerID,
+		AssociationType:   provisioningkey.AssociationTypeConnectorGroup,
+		ProvisioningKeyID: id,
+	}
+}
+
+// model returns the ProvisioningKey that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *provisioningkey.ProvisioningKey {
+	return &provisioningkey.ProvisioningKey{
+		Name:             "example",
+		Enabled:          true,
+		MaxUsage:         "10",
+		EnrollmentCertID: enrollmentCertID,
+		ZComponentID:     appConnectorGroupID,
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: provisioningkey.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+	collection := "associationType/" + provisioningkey.AssociationTypeConnectorGroup + "/provisioningKey"
+
+	cr := &v1alpha1.ProvisioningKey{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ProvisioningKeyParameters{
+		CustomerID:       customerID,
+		AssociationType:  provisioningkey.AssociationTypeConnectorGroup,
+		MaxUsage:         "10",
+		EnrollmentCertID: enrollmentCertID,
+		ZComponentID:     zpaclient.String(srv.Seed(customerID, "appConnectorGroup", zpafake.Object{"name": "example"})),
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	externalName := meta.GetExternalName(cr)
+	want := managed.ConnectionDetails{v1alpha1.ConnectionSecretProvisioningKeyKey: []byte(zpafake.ProvisioningKeyPrefix + externalName)}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: want}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	if got, ok := srv.Get(customerID, collection, externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want provisioning key %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: want}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.MaxUsage = "20"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want provisioning key to be up to date after update, got %+v, %v", obs, err)
+	}
+	if diff := cmp.Diff(want, obs.ConnectionDetails); diff != "" {
+		t.Errorf("e.Observe(...): -want provisioning key to be unchanged by update, +got:\n%s\n", diff)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scimgroup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/scimgroup/v1alpha1"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+)
+
+const (
+	errNotSCIMGroup       = "managed resource is not a SCIMGroup custom resource"
+	errCreateNotSupported = "SCIM groups are provisioned by the identity provider and cannot be created, check spec.forProvider.name"
+	errNoIdpID            = "spec.forProvider.idpID is required to look up a SCIM group"
+)
+
+// SetupSCIMGroup adds a controller that reconciles SCIMGroups.
+func SetupSCIMGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SCIMGroupKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.SCIMGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SCIMGroupGroupVersionKind),
+			managed.WithExternalConnecter(&lookup.Connector{
+				Kube:               mgr.GetClient(),
+				NewClientFn:        lookup.New,
+				Logger:             logger,
+				Recorder:           recorder,
+				Kind:               v1alpha1.SCIMGroupKind,
+				CreateNotSupported: errCreateNotSupported,
+				Lookup:             lookupSCIMGroup,
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// lookupSCIMGroup looks up the SCIM group of the supplied SCIMGroup by its name.
+// It returns the ID of the SCIM group.
+func lookupSCIMGroup(ctx context.Context, client lookup.ClientService, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*v1alpha1.SCIMGroup)
+	if !ok {
+		return "", errors.New(errNotSCIMGroup)
+	}
+
+	if cr.Spec.ForProvider.IdpID == nil {
+		return "", errors.New(errNoIdpID)
+	}
+
+	obj, err := client.GetSCIMGroupByName(&lookup.GetSCIMGroupByNameParams{
+		Context:    ctx,
+		CustomerID: cr.Spec.ForProvider.CustomerID,
+		IdpID:      *cr.Spec.ForProvider.IdpID,
+		Name:       cr.Spec.ForProvider.Name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	return obj.ID.String(), nil
+}
+
+// generateObservation generates observation for the input object lookup.SCIMGroup
+func generateObservation(obj *lookup.SCIMGroup) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime: obj.CreationTime.String(),
+		ID:           obj.ID.String(),
+		ModifiedTime: obj.ModifiedTime.String(),
+		IdpGroupID:   obj.IdpGroupID,
+	}
+}
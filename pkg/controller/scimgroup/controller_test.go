@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scimgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/scimgroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+	groupName  = "Engineering"
+	idpID      = "72058304855015500"
+)
+
+type scimGroupModifier func(*v1alpha1.SCIMGroup)
+
+func withObservation(o v1alpha1.Observation) scimGroupModifier {
+	return func(cr *v1alpha1.SCIMGroup) { cr.Status.AtProvider = o }
+}
+
+func withoutIdpID() scimGroupModifier {
+	return func(cr *v1alpha1.SCIMGroup) { cr.Spec.ForProvider.IdpID = nil }
+}
+
+func scimGroup(m ...scimGroupModifier) *v1alpha1.SCIMGroup {
+	cr := &v1alpha1.SCIMGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.SCIMGroupParameters{CustomerID: customerID, IdpID: zpaclient.String(idpID), Name: groupName}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func payload() *lookup.SCIMGroup {
+	return &lookup.SCIMGroup{
+		ID:           id,
+		Name:         groupName,
+		IdpID:        idpID,
+		IdpGroupID:   "00g1a2b3c4d5e6f7g8h9",
+		CreationTime: "1633046400",
+		ModifiedTime: "1633050000",
+	}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedTime: "1633050000",
+	IdpGroupID:   "00g1a2b3c4d5e6f7g8h9",
+}
+
+func TestLookupSCIMGroup(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		id  string
+		err error
+	}
+
+	getParams := &lookup.GetSCIMGroupByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		IdpID:      idpID,
+		Name:       groupName,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklookup.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotSCIMGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotSCIMGroup)},
+		},
+		"NoIdpID": {
+			mg:   scimGroup(withoutIdpID()),
+			want: want{cr: scimGroup(withoutIdpID()), err: errors.New(errNoIdpID)},
+		},
+		"Found": {
+			mock: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetSCIMGroupByName(getParams).Return(payload(), nil)
+			},
+			mg:   scimGroup(),
+			want: want{cr: scimGroup(withObservation(observation)), id: id},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklookup.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+
+			id, err := lookupSCIMGroup(context.Background(), m, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nlookupSCIMGroup(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\nlookupSCIMGroup(...): -want ID, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\nlookupSCIMGroup(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
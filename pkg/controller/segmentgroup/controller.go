@@ -53,6 +53,8 @@ const (
 // SetupSegmentGroup adds a controller that reconciles SegmentGroups.
 func SetupSegmentGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1alpha1.SegmentGroupGroupKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -62,16 +64,18 @@ func SetupSegmentGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 		For(&v1alpha1.SegmentGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.SegmentGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: zpa.New}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: zpa.New, logger: logger, recorder: recorder}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal
+	logger      logging.Logger
+	recorder    event.Recorder
 }
 
 type external struct {
@@ -85,7 +89,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotSegmentGroup)
 	}
 
-	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg)
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,542 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package segment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpa "github.com/haarchri/zpa-go-client/pkg/client"
+	"github.com/haarchri/zpa-go-client/pkg/client/segment_group_controller"
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/segmentgroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mocksg "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/segment_group_controller"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+)
+
+var errBoom = errors.New("boom")
+
+type segmentGroupModifier func(*v1alpha1.SegmentGroup)
+
+func withExternalName(n string) segmentGroupModifier {
+	return func(cr *v1alpha1.SegmentGroup) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.SegmentGroupParameters) segmentGroupModifier {
+	return func(cr *v1alpha1.SegmentGroup) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) segmentGroupModifier {
+	return func(cr *v1alpha1.SegmentGroup) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) segmentGroupModifier {
+	return func(cr *v1alpha1.SegmentGroup) { cr.Status.AtProvider = o }
+}
+
+func segmentGroup(m ...segmentGroupModifier) *v1alpha1.SegmentGroup {
+	cr := &v1alpha1.SegmentGroup{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.SegmentGroupParameters)) v1alpha1.SegmentGroupParameters {
+	p := v1alpha1.SegmentGroupParameters{
+		CustomerID:          customerID,
+		ConfigSpace:         "DEFAULT",
+		Description:         "example",
+		Enabled:             zpaclient.Bool(true),
+		TCPKeepAliveEnabled: "1",
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.SegmentGroup)) *segment_group_controller.GetSegmentGroupUsingGET1OK {
+	p := &models.SegmentGroup{
+		ID:                  id,
+		Name:                zpaclient.String("example"),
+		ConfigSpace:         "DEFAULT",
+		Description:         "example",
+		Enabled:             true,
+		TCPKeepAliveEnabled: "1",
+		CreationTime:        "1633046400",
+		ModifiedBy:          "admin",
+		ModifiedTime:        "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return &segment_group_controller.GetSegmentGroupUsingGET1OK{Payload: p}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube func() *test.MockClient
+		mg   resource.Managed
+		want want
+	}{
+		"NotSegmentGroup": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotSegmentGroup)},
+		},
+		"NoProviderConfigRef": {
+			mg:   segmentGroup(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := segmentGroup()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal {
+					called = true
+					return zpa.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocksg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotSegmentGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotSegmentGroup)},
+		},
+		"NoExternalName": {
+			mg:   segmentGroup(withSpec(params())),
+			want: want{cr: segmentGroup(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(nil, &segment_group_controller.GetSegmentGroupUsingGET1BadRequest{})
+			},
+			mg:   segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: segmentGroup(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(nil, errBoom)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(payload(), nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(payload(), nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params(func(p *v1alpha1.SegmentGroupParameters) {
+				p.ConfigSpace = ""
+				p.TCPKeepAliveEnabled = ""
+			}))),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"DescriptionDrift": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(payload(func(p *models.SegmentGroup) { p.Description = "changed" }), nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ConfigSpaceDrift": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(payload(func(p *models.SegmentGroup) { p.ConfigSpace = "SIEM" }), nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"TCPKeepAliveEnabledDrift": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().GetSegmentGroupUsingGET1(getParams()).Return(payload(func(p *models.SegmentGroup) { p.TCPKeepAliveEnabled = "0" }), nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{SegmentGroupController: m}}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocksg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotSegmentGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotSegmentGroup)},
+		},
+		"Success": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().AddSegmentGroupUsingPOST1(&segment_group_controller.AddSegmentGroupUsingPOST1Params{
+					Context:      context.Background(),
+					CustomerID:   customerID,
+					SegmentGroup: model(""),
+				}).Return(&segment_group_controller.AddSegmentGroupUsingPOST1Created{Payload: model(id)}, nil)
+			},
+			mg: segmentGroup(withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().AddSegmentGroupUsingPOST1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: segmentGroup(withSpec(params())),
+			want: want{
+				cr:  segmentGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{SegmentGroupController: m}}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mocksg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotSegmentGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotSegmentGroup),
+		},
+		"Success": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().UpdateSegmentGroupUsingPUT1(&segment_group_controller.UpdateSegmentGroupUsingPUT1Params{
+					Context:        context.Background(),
+					CustomerID:     customerID,
+					SegmentGroupID: id,
+					SegmentGroup:   model(id),
+				}).Return(nil, &segment_group_controller.UpdateSegmentGroupUsingPUT1NoContent{}, nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().UpdateSegmentGroupUsingPUT1(gomock.Any()).Return(nil, nil, errBoom)
+			},
+			mg:   segmentGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{SegmentGroupController: m}}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mocksg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotSegmentGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotSegmentGroup),
+		},
+		"NoExternalName": {
+			mg:   segmentGroup(withSpec(params())),
+			want: errors.New(errNotSegmentGroup),
+		},
+		"Success": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().DeleteSegmentGroupUsingDELETE1(&segment_group_controller.DeleteSegmentGroupUsingDELETE1Params{
+					Context:        context.Background(),
+					CustomerID:     customerID,
+					SegmentGroupID: id,
+				}).Return(&segment_group_controller.DeleteSegmentGroupUsingDELETE1NoContent{}, nil)
+			},
+			mg: segmentGroup(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mocksg.MockClientService) {
+				m.EXPECT().DeleteSegmentGroupUsingDELETE1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg:   segmentGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{SegmentGroupController: m}}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *segment_group_controller.GetSegmentGroupUsingGET1Params {
+	return &segment_group_controller.GetSegmentGroupUsingGET1Params{
+		Context:        context.Background(),
+		CustomerID:     customerID,
+		SegmentGroupID: id,
+	}
+}
+
+// model returns the SegmentGroup that is sent to the ZPA API for the
+// parameters returned by params.
+func model(id string) *models.SegmentGroup {
+	return &models.SegmentGroup{
+		ID:                  id,
+		Name:                zpaclient.String("example"),
+		ConfigSpace:         "DEFAULT",
+		Description:         "example",
+		Enabled:             true,
+		TCPKeepAliveEnabled: "1",
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: zpa.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.SegmentGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.SegmentGroupParameters{
+		CustomerID:  customerID,
+		Description: "example segment group",
+		Enabled:     zpaclient.Bool(true),
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "segmentGroup", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want segment group %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpafake.DefaultConfigSpace, cr.Spec.ForProvider.ConfigSpace); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized configSpace, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Description = "updated segment group"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want segment group to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "segmentGroup", externalName); got["description"] != "updated segment group" {
+		t.Errorf("e.Update(...): want description to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
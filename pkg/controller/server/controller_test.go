@@ -0,0 +1,557 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpa "github.com/haarchri/zpa-go-client/pkg/client"
+	"github.com/haarchri/zpa-go-client/pkg/client/app_server_controller"
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/server/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mocksrv "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/app_server_controller"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+)
+
+var errBoom = errors.New("boom")
+
+type serverModifier func(*v1alpha1.Server)
+
+func withExternalName(n string) serverModifier {
+	return func(cr *v1alpha1.Server) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ServerParameters) serverModifier {
+	return func(cr *v1alpha1.Server) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) serverModifier {
+	return func(cr *v1alpha1.Server) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) serverModifier {
+	return func(cr *v1alpha1.Server) { cr.Status.AtProvider = o }
+}
+
+func server(m ...serverModifier) *v1alpha1.Server {
+	cr := &v1alpha1.Server{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ServerParameters)) v1alpha1.ServerParameters {
+	p := v1alpha1.ServerParameters{
+		CustomerID:        customerID,
+		Address:           "192.0.2.1",
+		ConfigSpace:       "DEFAULT",
+		Description:       "example",
+		Enabled:           zpaclient.Bool(true),
+		AppServerGroupIds: []string{"72058304855015500"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.ApplicationServer)) *app_server_controller.GetAppServerUsingGET1OK {
+	p := &models.ApplicationServer{
+		ID:                id,
+		Name:              zpaclient.String("example"),
+		Address:           "192.0.2.1",
+		ConfigSpace:       "DEFAULT",
+		Description:       "example",
+		Enabled:           true,
+		AppServerGroupIds: []string{"72058304855015500"},
+		CreationTime:      "1633046400",
+		ModifiedBy:        "admin",
+		ModifiedTime:      "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return &app_server_controller.GetAppServerUsingGET1OK{Payload: p}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotServer": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotServer)},
+		},
+		"NoProviderConfigRef": {
+			mg:   server(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := server()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal {
+					called = true
+					return zpa.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*models.ApplicationServer)) func(m *mocksrv.MockClientService) {
+		return func(m *mocksrv.MockClientService) {
+			m.EXPECT().GetAppServerUsingGET1(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := server(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+
+	cases := map[string]struct {
+		mock func(m *mocksrv.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServer": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServer)},
+		},
+		"NoExternalName": {
+			mg:   server(withSpec(params())),
+			want: want{cr: server(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().GetAppServerUsingGET1(getParams()).Return(nil, &app_server_controller.GetAppServerUsingGET1BadRequest{})
+			},
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: server(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().GetAppServerUsingGET1(getParams()).Return(nil, errBoom)
+			},
+			mg: server(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  server(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*models.ApplicationServer) {}),
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*models.ApplicationServer) {}),
+			mg:   server(withExternalName(id), withSpec(params(func(p *v1alpha1.ServerParameters) { p.ConfigSpace = "" }))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *models.ApplicationServer) { p.Description = "changed" }),
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"ConfigSpaceDrift": {
+			mock: drift(func(p *models.ApplicationServer) { p.ConfigSpace = "SIEM" }),
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"AddressDrift": {
+			mock: drift(func(p *models.ApplicationServer) { p.Address = "192.0.2.2" }),
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"AppServerGroupIdsDrift": {
+			mock: drift(func(p *models.ApplicationServer) { p.AppServerGroupIds = nil }),
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksrv.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{AppServerController: m}}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocksrv.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServer": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServer)},
+		},
+		"Success": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().AddAppServerUsingPOST1(&app_server_controller.AddAppServerUsingPOST1Params{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					Server:     model([]string{"72058304855015500"}),
+				}).Return(&app_server_controller.AddAppServerUsingPOST1Created{Payload: &models.ApplicationServer{ID: id}}, nil)
+			},
+			mg: server(withSpec(params())),
+			want: want{
+				cr:  server(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().AddAppServerUsingPOST1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: server(withSpec(params())),
+			want: want{
+				cr:  server(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksrv.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{AppServerController: m}}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mocksrv.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServer": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServer),
+		},
+		"Success": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().UpdateAppServerUsingPUT1(updateParams([]string{"72058304855015500"})).Return(nil, &app_server_controller.UpdateAppServerUsingPUT1NoContent{}, nil)
+			},
+			mg: server(withExternalName(id), withSpec(params())),
+		},
+		"NoAppServerGroups": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().UpdateAppServerUsingPUT1(updateParams([]string{})).Return(nil, &app_server_controller.UpdateAppServerUsingPUT1NoContent{}, nil)
+			},
+			mg: server(withExternalName(id), withSpec(params(func(p *v1alpha1.ServerParameters) { p.AppServerGroupIds = nil }))),
+		},
+		"UpdateFailed": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().UpdateAppServerUsingPUT1(gomock.Any()).Return(nil, nil, errBoom)
+			},
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksrv.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{AppServerController: m}}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	deleteParams := &app_server_controller.DeleteAppServerUsingDELETE1Params{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		ServerID:   id,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocksrv.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServer": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServer),
+		},
+		"NoExternalName": {
+			mg:   server(withSpec(params())),
+			want: errors.New(errNotServer),
+		},
+		"Success": {
+			mock: func(m *mocksrv.MockClientService) {
+				gomock.InOrder(
+					m.EXPECT().UpdateAppServerUsingPUT1(updateParams([]string{})).Return(nil, &app_server_controller.UpdateAppServerUsingPUT1NoContent{}, nil),
+					m.EXPECT().DeleteAppServerUsingDELETE1(deleteParams).Return(&app_server_controller.DeleteAppServerUsingDELETE1NoContent{}, nil),
+				)
+			},
+			mg: server(withExternalName(id), withSpec(params())),
+		},
+		"NoAppServerGroups": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().DeleteAppServerUsingDELETE1(deleteParams).Return(&app_server_controller.DeleteAppServerUsingDELETE1NoContent{}, nil)
+			},
+			mg: server(withExternalName(id), withSpec(params(func(p *v1alpha1.ServerParameters) { p.AppServerGroupIds = nil }))),
+		},
+		"DetachFailed": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().UpdateAppServerUsingPUT1(gomock.Any()).Return(nil, nil, errBoom)
+			},
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"DeleteFailed": {
+			mock: func(m *mocksrv.MockClientService) {
+				m.EXPECT().UpdateAppServerUsingPUT1(gomock.Any()).Return(nil, &app_server_controller.UpdateAppServerUsingPUT1NoContent{}, nil)
+				m.EXPECT().DeleteAppServerUsingDELETE1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg:   server(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocksrv.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: &zpa.ZscalerPrivateAccessAPIPortal{AppServerController: m}}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *app_server_controller.GetAppServerUsingGET1Params {
+	return &app_server_controller.GetAppServerUsingGET1Params{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		ServerID:   id,
+	}
+}
+
+func updateParams(groups []string) *app_server_controller.UpdateAppServerUsingPUT1Params {
+	return &app_server_controller.UpdateAppServerUsingPUT1Params{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		ServerID:   id,
+		Server:     model(groups),
+	}
+}
+
+// model returns the ApplicationServer that is sent to the ZPA API for the
+// parameters returned by params.
+func model(groups []string) *models.ApplicationServer {
+	return &models.ApplicationServer{
+		Name:              zpaclient.String("example"),
+		Address:           "192.0.2.1",
+		ConfigSpace:       "DEFAULT",
+		Description:       "example",
+		Enabled:           true,
+		AppServerGroupIds: groups,
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: zpa.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.Server{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ServerParameters{
+		CustomerID:  customerID,
+		Address:     "192.0.2.1",
+		Description: "example server",
+		Enabled:     zpaclient.Bool(true),
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "server", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want server %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpafake.DefaultConfigSpace, cr.Spec.ForProvider.ConfigSpace); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized configSpace, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Address = "192.0.2.2"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want server to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "server", externalName); got["address"] != "192.0.2.2" {
+		t.Errorf("e.Update(...): want address to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,596 @@
+/*
+Copyright 2021 The Crossplane Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	zpa "github.com/haarchri/zpa-go-client/pkg/client"
+	"github.com/haarchri/zpa-go-client/pkg/client/connector_group_controller"
+	"github.com/haarchri/zpa-go-client/pkg/client/server_group_controller"
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/servergroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockcg "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/connector_group_controller"
+	mocksg "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/server_group_controller"
+)
+
+const (
+	customerID       = "72058304855015424"
+	id               = "72058304855015574"
+	connectorGroupID = "72058304855015500"
+)
+
+var errBoom = errors.New("boom")
+
+type serverGroupModifier func(*v1alpha1.ServerGroup)
+
+func withExternalName(n string) serverGroupModifier {
+	return func(cr *v1alpha1.ServerGroup) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ServerGroupParameters) serverGroupModifier {
+	return func(cr *v1alpha1.ServerGroup) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) serverGroupModifier {
+	return func(cr *v1alpha1.ServerGroup) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) serverGroupModifier {
+	return func(cr *v1alpha1.ServerGroup) { cr.Status.AtProvider = o }
+}
+
+func serverGroup(m ...serverGroupModifier) *v1alpha1.ServerGroup {
+	cr := &v1alpha1.ServerGroup{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ServerGroupParameters)) v1alpha1.ServerGroupParameters {
+	p := v1alpha1.ServerGroupParameters{
+		CustomerID:         customerID,
+		ConfigSpace:        "DEFAULT",
+		Description:        "example",
+		Enabled:            zpaclient.Bool(true),
+		IPAnchored:         zpaclient.Bool(false),
+		DynamicDiscovery:   true,
+		AppConnectorGroups: []string{connectorGroupID},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*models.ServerGroupDTO)) *server_group_controller.GetServerGroupUsingGET1OK {
+	p := &models.ServerGroupDTO{
+		ID:               id,
+		Name:             "example",
+		ConfigSpace:      "DEFAULT",
+		Description:      "example",
+		Enabled:          true,
+		IPAnchored:       false,
+		DynamicDiscovery: true,
+		CreationTime:     "1633046400",
+		ModifiedBy:       "admin",
+		ModifiedTime:     "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return &server_group_controller.GetServerGroupUsingGET1OK{Payload: p}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+}
+
+type mocks struct {
+	serverGroup    *mocksg.MockClientService
+	connectorGroup *mockcg.MockClientService
+}
+
+func newExternal(ctrl *gomock.Controller, mock func(m mocks)) *external {
+	m := mocks{
+		serverGroup:    mocksg.NewMockClientService(ctrl),
+		connectorGroup: mockcg.NewMockClientService(ctrl),
+	}
+	if mock != nil {
+		mock(m)
+	}
+	return &external{client: &zpa.ZscalerPrivateAccessAPIPortal{
+		ServerGroupController:    m.serverGroup,
+		ConnectorGroupController: m.connectorGroup,
+	}}
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotServerGroup": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotServer)},
+		},
+		"NoProviderConfigRef": {
+			mg:   serverGroup(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := serverGroup()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) *zpa.ZscalerPrivateAccessAPIPortal {
+					called = true
+					return zpa.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*models.ServerGroupDTO)) func(m mocks) {
+		return func(m mocks) {
+			m.serverGroup.EXPECT().GetServerGroupUsingGET1(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := serverGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+
+	cases := map[string]struct {
+		mock func(m mocks)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServerGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServer)},
+		},
+		"NoExternalName": {
+			mg:   serverGroup(withSpec(params())),
+			want: want{cr: serverGroup(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m mocks) {
+				m.serverGroup.EXPECT().GetServerGroupUsingGET1(getParams()).Return(nil, &server_group_controller.GetServerGroupUsingGET1BadRequest{})
+			},
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: serverGroup(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m mocks) {
+				m.serverGroup.EXPECT().GetServerGroupUsingGET1(getParams()).Return(nil, errBoom)
+			},
+			mg: serverGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  serverGroup(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*models.ServerGroupDTO) {}),
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*models.ServerGroupDTO) {}),
+			mg: serverGroup(withExternalName(id), withSpec(params(func(p *v1alpha1.ServerGroupParameters) {
+				p.ConfigSpace = ""
+				p.IPAnchored = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *models.ServerGroupDTO) { p.Description = "changed" }),
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"ConfigSpaceDrift": {
+			mock: drift(func(p *models.ServerGroupDTO) { p.ConfigSpace = "SIEM" }),
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"IPAnchoredDrift": {
+			mock: drift(func(p *models.ServerGroupDTO) { p.IPAnchored = true }),
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"DynamicDiscoveryDrift": {
+			mock: drift(func(p *models.ServerGroupDTO) { p.DynamicDiscovery = false }),
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := newExternal(ctrl, tc.mock)
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m mocks)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServerGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServer)},
+		},
+		"Success": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(connectorGroup(), nil)
+				m.serverGroup.EXPECT().AddAppServerGroupUsingPOST1(&server_group_controller.AddAppServerGroupUsingPOST1Params{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					Group:      model(),
+				}).Return(&server_group_controller.AddAppServerGroupUsingPOST1Created{Payload: &models.ServerGroupDTO{ID: id}}, nil)
+			},
+			mg: serverGroup(withSpec(params())),
+			want: want{
+				cr:  serverGroup(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"ConnectorGroupNotFound": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(nil, errBoom)
+			},
+			mg: serverGroup(withSpec(params())),
+			want: want{
+				cr:  serverGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateConnectorGroupNotFound),
+			},
+		},
+		"CreateFailed": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(connectorGroup(), nil)
+				m.serverGroup.EXPECT().AddAppServerGroupUsingPOST1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: serverGroup(withSpec(params())),
+			want: want{
+				cr:  serverGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := newExternal(ctrl, tc.mock)
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m mocks)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServerGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServer),
+		},
+		"Success": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(connectorGroup(), nil)
+				m.serverGroup.EXPECT().UpdateAppServerGroupUsingPUT1(&server_group_controller.UpdateAppServerGroupUsingPUT1Params{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					GroupID:    id,
+					Group:      model(),
+				}).Return(nil, &server_group_controller.UpdateAppServerGroupUsingPUT1NoContent{}, nil)
+			},
+			mg: serverGroup(withExternalName(id), withSpec(params())),
+		},
+		"ConnectorGroupNotFound": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(nil, errBoom)
+			},
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateConnectorGroupNotFound),
+		},
+		"UpdateFailed": {
+			mock: func(m mocks) {
+				m.connectorGroup.EXPECT().GetAppConnectorGroupUsingGET1(connectorGroupParams()).Return(connectorGroup(), nil)
+				m.serverGroup.EXPECT().UpdateAppServerGroupUsingPUT1(gomock.Any()).Return(nil, nil, errBoom)
+			},
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := newExternal(ctrl, tc.mock)
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m mocks)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServerGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServer),
+		},
+		"NoExternalName": {
+			mg:   serverGroup(withSpec(params())),
+			want: errors.New(errNotServer),
+		},
+		"Success": {
+			mock: func(m mocks) {
+				m.serverGroup.EXPECT().DeleteAppServerGroupUsingDELETE1(&server_group_controller.DeleteAppServerGroupUsingDELETE1Params{
+					Context:    context.Background(),
+					CustomerID: customerID,
+					GroupID:    id,
+				}).Return(&server_group_controller.DeleteAppServerGroupUsingDELETE1NoContent{}, nil)
+			},
+			mg: serverGroup(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m mocks) {
+				m.serverGroup.EXPECT().DeleteAppServerGroupUsingDELETE1(gomock.Any()).Return(nil, errBoom)
+			},
+			mg:   serverGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			e := newExternal(ctrl, tc.mock)
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *server_group_controller.GetServerGroupUsingGET1Params {
+	return &server_group_controller.GetServerGroupUsingGET1Params{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		GroupID:    id,
+	}
+}
+
+func connectorGroupParams() *connector_group_controller.GetAppConnectorGroupUsingGET1Params {
+	return &connector_group_controller.GetAppConnectorGroupUsingGET1Params{
+		Context:             context.Background(),
+		CustomerID:          customerID,
+		AppConnectorGroupID: connectorGroupID,
+	}
+}
+
+func connectorGroup() *connector_group_controller.GetAppConnectorGroupUsingGET1OK {
+	return &connector_group_controller.GetAppConnectorGroupUsingGET1OK{Payload: &models.AppConnectorGroup{
+		ID:   connectorGroupID,
+		Name: zpaclient.String("connectors"),
+	}}
+}
+
+// model returns the ServerGroupDTO that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *models.ServerGroupDTO {
+	return &models.ServerGroupDTO{
+		Name:               "example",
+		ConfigSpace:        "DEFAULT",
+		Description:        "example",
+		Enabled:            true,
+		DynamicDiscovery:   true,
+		AppConnectorGroups: []*models.AppConnectorGroup{{ID: connectorGroupID, Name: zpaclient.String("connectors")}},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	seededConnectorGroupID := srv.Seed(customerID, "appConnectorGroup", zpafake.Object{"name": "example"})
+	e := &external{client: zpa.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.ServerGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ServerGroupParameters{
+		CustomerID:         customerID,
+		Description:        "example server group",
+		Enabled:            zpaclient.Bool(true),
+		AppConnectorGroups: []string{seededConnectorGroupID},
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "serverGroup", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want server group %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpafake.DefaultConfigSpace, cr.Spec.ForProvider.ConfigSpace); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized configSpace, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Description = "updated server group"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want server group to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "serverGroup", externalName); got["description"] != "updated server group" {
+		t.Errorf("e.Update(...): want description to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestCreateUnknownConnectorGroup(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	e := &external{client: zpa.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.ServerGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ServerGroupParameters{
+		CustomerID:         customerID,
+		AppConnectorGroups: []string{"404"},
+	}
+
+	if _, err := e.Create(context.Background(), cr); err == nil {
+		t.Fatalf("e.Create(...): want error for unknown app connector group, got nil")
+	}
+	if diff := cmp.Diff(0, srv.Requests["POST /mgmtconfig/v1/admin/customers/"+customerID+"/serverGroup"]); diff != "" {
+		t.Errorf("e.Create(...): -want create requests, +got:\n%s\n", diff)
+	}
+}
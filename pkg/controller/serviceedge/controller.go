@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceedge
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedge/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/enrolled"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedge"
+)
+
+const (
+	errNotServiceEdge = "managed resource is not a ServiceEdge custom resource"
+)
+
+// SetupServiceEdge adds a controller that reconciles ServiceEdges.
+func SetupServiceEdge(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.ServiceEdgeKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ServiceEdge{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceEdgeGroupVersionKind),
+			managed.WithExternalConnecter(&enrolled.Connector{
+				Kube:     mgr.GetClient(),
+				Logger:   logger,
+				Recorder: recorder,
+				Kind:     v1alpha1.ServiceEdgeKind,
+				Title:    "Service Edge",
+				NewComponentFn: func(transport runtime.ClientTransport) enrolled.ComponentFn {
+					return componentFn(serviceedge.New(transport, strfmt.Default))
+				},
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// component adapts a ServiceEdge to the enrolled.External.
+type component struct {
+	cr     *v1alpha1.ServiceEdge
+	client serviceedge.ClientService
+}
+
+// componentFn returns an enrolled.ComponentFn that manages Service Edges with
+// the supplied client.
+func componentFn(client serviceedge.ClientService) enrolled.ComponentFn {
+	return func(mg resource.Managed) (enrolled.Component, error) {
+		cr, ok := mg.(*v1alpha1.ServiceEdge)
+		if !ok {
+			return nil, errors.New(errNotServiceEdge)
+		}
+		return &component{cr: cr, client: client}, nil
+	}
+}
+
+func (c *component) get(ctx context.Context, id string) (*serviceedge.ServiceEdge, error) {
+	return c.client.GetServiceEdge(&serviceedge.GetServiceEdgeParams{
+		Context:       ctx,
+		CustomerID:    c.cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeID: id,
+	})
+}
+
+func (c *component) Observe(ctx context.Context, id string) (enrolled.Observation, error) {
+	obj, err := c.get(ctx, id)
+	if err != nil {
+		return enrolled.Observation{}, err
+	}
+
+	c.cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := c.cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(c.cr, obj)
+
+	return enrolled.Observation{
+		ControlChannelStatus: obj.ControlChannelStatus,
+		UpToDate:             isUpToDate(&c.cr.Spec.ForProvider, obj),
+		LateInitialized:      !cmp.Equal(&c.cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (c *component) Update(ctx context.Context, id string) error {
+	// The ZPA API replaces the whole Service Edge, so the fields that are not
+	// managed by the ServiceEdge, like its name, are sent as they are.
+	obj, err := c.get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj.Enabled = zpaclient.BoolValue(c.cr.Spec.ForProvider.Enabled)
+	obj.Description = c.cr.Spec.ForProvider.Description
+	obj.ServiceEdgeGroupID = zpaclient.StringValue(c.cr.Spec.ForProvider.ServiceEdgeGroupID)
+
+	return c.client.UpdateServiceEdge(&serviceedge.UpdateServiceEdgeParams{
+		Context:       ctx,
+		CustomerID:    c.cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeID: id,
+		ServiceEdge:   obj,
+	})
+}
+
+func (c *component) Delete(ctx context.Context, id string) error {
+	return c.client.DeleteServiceEdge(&serviceedge.DeleteServiceEdgeParams{
+		Context:       ctx,
+		CustomerID:    c.cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeID: id,
+	})
+}
+
+func lateInitialize(cr *v1alpha1.ServiceEdge, obj *serviceedge.ServiceEdge) {
+	p := &cr.Spec.ForProvider
+
+	if p.Enabled == nil {
+		p.Enabled = zpaclient.Bool(obj.Enabled)
+	}
+
+	if p.Description == "" && obj.Description != "" {
+		p.Description = obj.Description
+	}
+
+	if p.ServiceEdgeGroupID == nil && obj.ServiceEdgeGroupID != "" {
+		p.ServiceEdgeGroupID = zpaclient.String(obj.ServiceEdgeGroupID)
+	}
+}
+
+// generateObservation generates observation for the input object serviceedge.ServiceEdge
+func generateObservation(obj *serviceedge.ServiceEdge) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime:             obj.CreationTime,
+		ID:                       obj.ID,
+		ModifiedBy:               obj.ModifiedBy,
+		ModifiedTime:             obj.ModifiedTime,
+		ServiceEdgeGroupName:     obj.ServiceEdgeGroupName,
+		ControlChannelStatus:     obj.ControlChannelStatus,
+		CtrlBrokerName:           obj.CtrlBrokerName,
+		CurrentVersion:           obj.CurrentVersion,
+		ExpectedVersion:          obj.ExpectedVersion,
+		UpgradeStatus:            obj.UpgradeStatus,
+		LastBrokerConnectTime:    obj.LastBrokerConnectTime,
+		LastBrokerDisconnectTime: obj.LastBrokerDisconnectTime,
+		Platform:                 obj.Platform,
+		PrivateIP:                obj.PrivateIP,
+		PublicIP:                 obj.PublicIP,
+	}
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.ServiceEdgeParameters, obj *serviceedge.ServiceEdge) bool {
+	if !zpaclient.IsEqualBool(cr.Enabled, zpaclient.Bool(obj.Enabled)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualString(zpaclient.StringToPtr(cr.Description), zpaclient.StringToPtr(obj.Description)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualString(cr.ServiceEdgeGroupID, zpaclient.StringToPtr(obj.ServiceEdgeGroupID)) {
+		return false
+	}
+
+	return true
+}
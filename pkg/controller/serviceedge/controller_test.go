@@ -0,0 +1,530 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceedge
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedge/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/enrolled"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockse "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/serviceedge"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedge"
+)
+
+const (
+	customerID         = "72058304855015424"
+	id                 = "72058304855015574"
+	serviceEdgeGroupID = "72058304855015500"
+)
+
+// Errors of the enrolled.External of ServiceEdges.
+const (
+	errCreateNotSupported = "Service Edges enroll using a provisioning key and cannot be created, set the external name annotation to the ID of an existing Service Edge to adopt it"
+	errUpdateFailed       = "cannot update ServiceEdge"
+	errDescribeFailed     = "cannot describe ServiceEdge"
+	errDeleteFailed       = "cannot delete ServiceEdge"
+	errNoExternalName     = "ServiceEdge has no external name"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type serviceEdgeModifier func(*v1alpha1.ServiceEdge)
+
+func withExternalName(n string) serviceEdgeModifier {
+	return func(cr *v1alpha1.ServiceEdge) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ServiceEdgeParameters) serviceEdgeModifier {
+	return func(cr *v1alpha1.ServiceEdge) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) serviceEdgeModifier {
+	return func(cr *v1alpha1.ServiceEdge) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) serviceEdgeModifier {
+	return func(cr *v1alpha1.ServiceEdge) { cr.Status.AtProvider = o }
+}
+
+func serviceEdge(m ...serviceEdgeModifier) *v1alpha1.ServiceEdge {
+	cr := &v1alpha1.ServiceEdge{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ServiceEdgeParameters)) v1alpha1.ServiceEdgeParameters {
+	p := v1alpha1.ServiceEdgeParameters{
+		CustomerID:         customerID,
+		Enabled:            zpaclient.Bool(true),
+		Description:        "example",
+		ServiceEdgeGroupID: zpaclient.String(serviceEdgeGroupID),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*serviceedge.ServiceEdge)) *serviceedge.ServiceEdge {
+	p := &serviceedge.ServiceEdge{
+		ID:                    id,
+		Name:                  "service-edge-1",
+		Enabled:               true,
+		Description:           "example",
+		ServiceEdgeGroupID:    serviceEdgeGroupID,
+		ServiceEdgeGroupName:  "example",
+		ControlChannelStatus:  "ZPN_STATUS_AUTHENTICATED",
+		CurrentVersion:        "21.49.1",
+		LastBrokerConnectTime: "1633046400000000",
+		PrivateIP:             "10.0.0.10",
+		CreationTime:          "1633046400",
+		ModifiedBy:            "admin",
+		ModifiedTime:          "1633050000",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                    id,
+	CreationTime:          "1633046400",
+	ModifiedBy:            "admin",
+	ModifiedTime:          "1633050000",
+	ServiceEdgeGroupName:  "example",
+	ControlChannelStatus:  "ZPN_STATUS_AUTHENTICATED",
+	CurrentVersion:        "21.49.1",
+	LastBrokerConnectTime: "1633046400000000",
+	PrivateIP:             "10.0.0.10",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceEdge": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: zpafake.ProviderConfigName}}},
+			want: want{called: true, err: errors.New(errNotServiceEdge)},
+		},
+		"NoProviderConfigRef": {
+			mg:   serviceEdge(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := serviceEdge()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &enrolled.Connector{
+				Kube:  srv.Kube(),
+				Kind:  v1alpha1.ServiceEdgeKind,
+				Title: "Service Edge",
+				NewComponentFn: func(transport runtime.ClientTransport) enrolled.ComponentFn {
+					called = true
+					return componentFn(serviceedge.New(transport, strfmt.Default))
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want NewComponentFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*serviceedge.ServiceEdge)) func(m *mockse.MockClientService) {
+		return func(m *mockse.MockClientService) {
+			m.EXPECT().GetServiceEdge(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := serviceEdge(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockse.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceEdge": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServiceEdge)},
+		},
+		"NoExternalName": {
+			mg:   serviceEdge(withSpec(params())),
+			want: want{cr: serviceEdge(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().GetServiceEdge(getParams()).Return(nil, errNotFound)
+			},
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: want{cr: serviceEdge(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().GetServiceEdge(getParams()).Return(nil, errBoom)
+			},
+			mg: serviceEdge(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  serviceEdge(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*serviceedge.ServiceEdge) {}),
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"Disconnected": {
+			mock: drift(func(p *serviceedge.ServiceEdge) { p.ControlChannelStatus = enrolled.ControlChannelDisconnected }),
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: want{
+				cr: serviceEdge(withExternalName(id), withSpec(params()), withConditions(xpv1.Unavailable()), withObservation(func() v1alpha1.Observation {
+					o := observation
+					o.ControlChannelStatus = enrolled.ControlChannelDisconnected
+					return o
+				}())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialize": {
+			mock: drift(func(*serviceedge.ServiceEdge) {}),
+			mg: serviceEdge(withExternalName(id), withSpec(params(func(p *v1alpha1.ServiceEdgeParameters) {
+				p.Enabled = nil
+				p.Description = ""
+				p.ServiceEdgeGroupID = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *serviceedge.ServiceEdge) { p.Enabled = false }),
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *serviceedge.ServiceEdge) { p.Description = "changed" }),
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"ServiceEdgeGroupIDDrift": {
+			mock: drift(func(p *serviceedge.ServiceEdge) { p.ServiceEdgeGroupID = "72058304855015501" }),
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockse.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.ServiceEdgeKind, Title: "Service Edge", Component: componentFn(m)}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceEdge": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServiceEdge),
+		},
+		"NotSupported": {
+			mg:   serviceEdge(withSpec(params())),
+			want: errors.New(errCreateNotSupported),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &enrolled.External{Kind: v1alpha1.ServiceEdgeKind, Title: "Service Edge", Component: componentFn(nil)}
+
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockse.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceEdge": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServiceEdge),
+		},
+		"Success": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().GetServiceEdge(getParams()).Return(payload(func(p *serviceedge.ServiceEdge) {
+					p.Enabled = false
+					p.ServiceEdgeGroupID = "72058304855015501"
+				}), nil)
+				m.EXPECT().UpdateServiceEdge(&serviceedge.UpdateServiceEdgeParams{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					ServiceEdgeID: id,
+					ServiceEdge:   payload(),
+				}).Return(nil)
+			},
+			mg: serviceEdge(withExternalName(id), withSpec(params())),
+		},
+		"GetFailed": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().GetServiceEdge(getParams()).Return(nil, errBoom)
+			},
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().GetServiceEdge(getParams()).Return(payload(), nil)
+				m.EXPECT().UpdateServiceEdge(gomock.Any()).Return(errBoom)
+			},
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockse.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.ServiceEdgeKind, Title: "Service Edge", Component: componentFn(m)}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockse.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceEdge": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServiceEdge),
+		},
+		"NoExternalName": {
+			mg:   serviceEdge(withSpec(params())),
+			want: errors.New(errNoExternalName),
+		},
+		"Success": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().DeleteServiceEdge(&serviceedge.DeleteServiceEdgeParams{
+					Context:       context.Background(),
+					CustomerID:    customerID,
+					ServiceEdgeID: id,
+				}).Return(nil)
+			},
+			mg: serviceEdge(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().DeleteServiceEdge(gomock.Any()).Return(errNotFound)
+			},
+			mg: serviceEdge(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockse.MockClientService) {
+				m.EXPECT().DeleteServiceEdge(gomock.Any()).Return(errBoom)
+			},
+			mg:   serviceEdge(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockse.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &enrolled.External{Kind: v1alpha1.ServiceEdgeKind, Title: "Service Edge", Component: componentFn(m)}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *serviceedge.GetServiceEdgeParams {
+	return &serviceedge.GetServiceEdgeParams{
+		Context:       context.Background(),
+		CustomerID:    customerID,
+		ServiceEdgeID: id,
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &enrolled.External{Kind: v1alpha1.ServiceEdgeKind, Title: "Service Edge", Component: componentFn(serviceedge.New(srv.Transport(), strfmt.Default))}
+
+	groupID := srv.Seed(customerID, "serviceEdgeGroup", zpafake.Object{"name": "example"})
+	otherGroupID := srv.Seed(customerID, "serviceEdgeGroup", zpafake.Object{"name": "other"})
+	externalName := srv.Seed(customerID, "serviceEdge", zpafake.Object{
+		"name":                 "service-edge-1",
+		"enabled":              true,
+		"serviceEdgeGroupId":   groupID,
+		"controlChannelStatus": "ZPN_STATUS_AUTHENTICATED",
+		"currentVersion":       "21.49.1",
+		"privateIp":            "10.0.0.10",
+	})
+
+	cr := &v1alpha1.ServiceEdge{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ServiceEdgeParameters{CustomerID: customerID}
+
+	if _, err := e.Create(ctx, cr); err == nil {
+		t.Errorf("e.Create(...): want error, got nil")
+	}
+
+	meta.SetExternalName(cr, externalName)
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpaclient.String(groupID), cr.Spec.ForProvider.ServiceEdgeGroupID); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized serviceEdgeGroupID, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff("10.0.0.10", cr.Status.AtProvider.PrivateIP); diff != "" {
+		t.Errorf("e.Observe(...): -want observed private IP, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.Enabled = zpaclient.Bool(false)
+	cr.Spec.ForProvider.ServiceEdgeGroupID = zpaclient.String(otherGroupID)
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want service edge to be up to date after update, got %+v, %v", obs, err)
+	}
+	got, _ := srv.Get(customerID, "serviceEdge", externalName)
+	if got["serviceEdgeGroupId"] != otherGroupID || got["name"] != "service-edge-1" {
+		t.Errorf("e.Update(...): want service edge to be moved and keep its name, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
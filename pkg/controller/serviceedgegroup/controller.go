@@ -0,0 +1,341 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceedgegroup
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedgegroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedgegroup"
+)
+
+const (
+	errNotServiceEdgeGroup = "managed resource is not a ServiceEdgeGroup custom resource"
+	errCreateFailed        = "cannot create ServiceEdgeGroup"
+	errUpdateFailed        = "cannot update ServiceEdgeGroup"
+	errDescribeFailed      = "cannot describe ServiceEdgeGroup"
+	errDeleteFailed        = "cannot delete ServiceEdgeGroup"
+)
+
+// SetupServiceEdgeGroup adds a controller that reconciles ServiceEdgeGroups.
+func SetupServiceEdgeGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.ServiceEdgeGroupKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ServiceEdgeGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceEdgeGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: serviceedgegroup.New, logger: logger, recorder: recorder}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(transport runtime.ClientTransport, formats strfmt.Registry) serviceedgegroup.ClientService
+	logger      logging.Logger
+	recorder    event.Recorder
+}
+
+type external struct {
+	client serviceedgegroup.ClientService
+	kube   client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1alpha1.ServiceEdgeGroup)
+	if !ok {
+		return nil, errors.New(errNotServiceEdgeGroup)
+	}
+
+	cfg, err := zpaclient.GetConfig(ctx, c.kube, mg, zpaclient.WithLogger(c.logger), zpaclient.WithRecorder(c.recorder))
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.newClientFn(cfg, strfmt.Default)
+	return &external{client, c.kube}, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceEdgeGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceEdgeGroup)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	req := &serviceedgegroup.GetServiceEdgeGroupParams{
+		Context:            ctx,
+		CustomerID:         cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeGroupID: id,
+	}
+	obj, reqErr := e.client.GetServiceEdgeGroup(req)
+	if reqErr != nil {
+		return managed.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(operation.IsNotFound, reqErr), errDescribeFailed)
+	}
+
+	cr.Status.AtProvider = generateObservation(obj)
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	e.LateInitialize(cr, obj)
+
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(&cr.Spec.ForProvider, obj),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceEdgeGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceEdgeGroup)
+	}
+
+	req := &serviceedgegroup.AddServiceEdgeGroupParams{
+		Context:          ctx,
+		CustomerID:       cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeGroup: generateServiceEdgeGroup(cr),
+	}
+
+	obj, err := e.client.AddServiceEdgeGroup(req)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, obj.ID)
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceEdgeGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceEdgeGroup)
+	}
+
+	req := &serviceedgegroup.UpdateServiceEdgeGroupParams{
+		Context:            ctx,
+		CustomerID:         cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeGroupID: meta.GetExternalName(cr),
+		ServiceEdgeGroup:   generateServiceEdgeGroup(cr),
+	}
+
+	if err := e.client.UpdateServiceEdgeGroup(req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceEdgeGroup)
+	if !ok {
+		return errors.New(errNotServiceEdgeGroup)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return errors.New(errNotServiceEdgeGroup)
+	}
+
+	req := &serviceedgegroup.DeleteServiceEdgeGroupParams{
+		Context:            ctx,
+		CustomerID:         cr.Spec.ForProvider.CustomerID,
+		ServiceEdgeGroupID: id,
+	}
+
+	if err := e.client.DeleteServiceEdgeGroup(req); err != nil {
+		return errors.Wrap(resource.Ignore(operation.IsNotFound, err), errDeleteFailed)
+	}
+
+	return nil
+}
+
+func (e *external) LateInitialize(cr *v1alpha1.ServiceEdgeGroup, obj *serviceedgegroup.ServiceEdgeGroup) { // nolint:gocyclo
+	p := &cr.Spec.ForProvider
+
+	if p.Enabled == nil {
+		p.Enabled = zpaclient.Bool(obj.Enabled)
+	}
+
+	if p.OverrideVersionProfile == nil {
+		p.OverrideVersionProfile = zpaclient.Bool(obj.OverrideVersionProfile)
+	}
+
+	if p.CityCountry == "" && obj.CityCountry != "" {
+		p.CityCountry = obj.CityCountry
+	}
+
+	if p.CountryCode == "" && obj.CountryCode != "" {
+		p.CountryCode = obj.CountryCode
+	}
+
+	if p.VersionProfileID == "" && obj.VersionProfileID != "" {
+		p.VersionProfileID = obj.VersionProfileID
+	}
+
+	if p.UpgradeDay == "" && obj.UpgradeDay != "" {
+		p.UpgradeDay = obj.UpgradeDay
+	}
+
+	if p.UpgradeTimeInSecs == "" && obj.UpgradeTimeInSecs != "" {
+		p.UpgradeTimeInSecs = obj.UpgradeTimeInSecs
+	}
+
+	if p.IsPublic == nil && obj.IsPublic != "" {
+		p.IsPublic = zpaclient.Bool(obj.IsPublic == serviceedgegroup.IsPublicTrue)
+	}
+
+	if len(p.TrustedNetworks) == 0 && len(obj.TrustedNetworks) > 0 {
+		p.TrustedNetworks = trustedNetworkIDs(obj.TrustedNetworks)
+	}
+}
+
+// generateServiceEdgeGroup generates the serviceedgegroup.ServiceEdgeGroup that is
+// sent to the ZPA API for the supplied ServiceEdgeGroup.
+func generateServiceEdgeGroup(cr *v1alpha1.ServiceEdgeGroup) *serviceedgegroup.ServiceEdgeGroup {
+	p := cr.Spec.ForProvider
+
+	return &serviceedgegroup.ServiceEdgeGroup{
+		Name:                   cr.Name,
+		Enabled:                zpaclient.BoolValue(p.Enabled),
+		Description:            p.Description,
+		CityCountry:            p.CityCountry,
+		CountryCode:            p.CountryCode,
+		Location:               p.Location,
+		Latitude:               p.Latitude,
+		Longitude:              p.Longitude,
+		VersionProfileID:       p.VersionProfileID,
+		OverrideVersionProfile: zpaclient.BoolValue(p.OverrideVersionProfile),
+		UpgradeDay:             p.UpgradeDay,
+		UpgradeTimeInSecs:      p.UpgradeTimeInSecs,
+		IsPublic:               isPublic(p.IsPublic),
+		TrustedNetworks:        trustedNetworks(p.TrustedNetworks),
+	}
+}
+
+// isPublic encodes the supplied IsPublic parameter as the ZPA API expects it.
+func isPublic(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	if *b {
+		return serviceedgegroup.IsPublicTrue
+	}
+	return serviceedgegroup.IsPublicFalse
+}
+
+// trustedNetworks returns the trusted networks with the supplied IDs.
+func trustedNetworks(ids []string) []serviceedgegroup.TrustedNetwork {
+	out := make([]serviceedgegroup.TrustedNetwork, len(ids))
+	for i, id := range ids {
+		out[i] = serviceedgegroup.TrustedNetwork{ID: id}
+	}
+	return out
+}
+
+// trustedNetworkIDs returns the IDs of the supplied trusted networks.
+func trustedNetworkIDs(in []serviceedgegroup.TrustedNetwork) []string {
+	out := make([]string, len(in))
+	for i, n := range in {
+		out[i] = n.ID
+	}
+	return out
+}
+
+// generateObservation generates observation for the input object serviceedgegroup.ServiceEdgeGroup
+func generateObservation(obj *serviceedgegroup.ServiceEdgeGroup) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime:       obj.CreationTime,
+		ID:                 obj.ID,
+		ModifiedBy:         obj.ModifiedBy,
+		ModifiedTime:       obj.ModifiedTime,
+		VersionProfileName: obj.VersionProfileName,
+	}
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.ServiceEdgeGroupParameters, obj *serviceedgegroup.ServiceEdgeGroup) bool { // nolint:gocyclo
+	if !zpaclient.IsEqualBool(cr.Enabled, zpaclient.Bool(obj.Enabled)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualBool(cr.OverrideVersionProfile, zpaclient.Bool(obj.OverrideVersionProfile)) {
+		return false
+	}
+
+	if !zpaclient.IsEqualStringArrayContent(cr.TrustedNetworks, trustedNetworkIDs(obj.TrustedNetworks)) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Description, obj.Description},
+		{cr.CityCountry, obj.CityCountry},
+		{cr.CountryCode, obj.CountryCode},
+		{cr.Location, obj.Location},
+		{cr.Latitude, obj.Latitude},
+		{cr.Longitude, obj.Longitude},
+		{cr.VersionProfileID, obj.VersionProfileID},
+		{cr.UpgradeDay, obj.UpgradeDay},
+		{cr.UpgradeTimeInSecs, obj.UpgradeTimeInSecs},
+		{isPublic(cr.IsPublic), obj.IsPublic},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,608 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceedgegroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/serviceedgegroup/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	zpafake "github.com/crossplane-contrib/provider-zpa/pkg/client/fake"
+	mockseg "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/serviceedgegroup"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/operation"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/serviceedgegroup"
+)
+
+const (
+	customerID = "72058304855015424"
+	id         = "72058304855015574"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	errNotFound = &operation.Error{Code: http.StatusBadRequest, ID: "resource.not.found"}
+)
+
+type serviceEdgeGroupModifier func(*v1alpha1.ServiceEdgeGroup)
+
+func withExternalName(n string) serviceEdgeGroupModifier {
+	return func(cr *v1alpha1.ServiceEdgeGroup) { meta.SetExternalName(cr, n) }
+}
+
+func withSpec(p v1alpha1.ServiceEdgeGroupParameters) serviceEdgeGroupModifier {
+	return func(cr *v1alpha1.ServiceEdgeGroup) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) serviceEdgeGroupModifier {
+	return func(cr *v1alpha1.ServiceEdgeGroup) { cr.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.Observation) serviceEdgeGroupModifier {
+	return func(cr *v1alpha1.ServiceEdgeGroup) { cr.Status.AtProvider = o }
+}
+
+func serviceEdgeGroup(m ...serviceEdgeGroupModifier) *v1alpha1.ServiceEdgeGroup {
+	cr := &v1alpha1.ServiceEdgeGroup{}
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ServiceEdgeGroupParameters)) v1alpha1.ServiceEdgeGroupParameters {
+	p := v1alpha1.ServiceEdgeGroupParameters{
+		CustomerID:             customerID,
+		Enabled:                zpaclient.Bool(true),
+		Description:            "example",
+		CityCountry:            "San Jose, US",
+		CountryCode:            "US",
+		Location:               "San Jose, CA, USA",
+		Latitude:               "37.3382082",
+		Longitude:              "-121.8863286",
+		VersionProfileID:       "0",
+		OverrideVersionProfile: zpaclient.Bool(true),
+		UpgradeDay:             "SUNDAY",
+		UpgradeTimeInSecs:      "66600",
+		IsPublic:               zpaclient.Bool(false),
+		TrustedNetworks:        []string{"72058304855015480", "72058304855015481"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func payload(m ...func(*serviceedgegroup.ServiceEdgeGroup)) *serviceedgegroup.ServiceEdgeGroup {
+	p := model()
+	p.ID = id
+	p.CreationTime = "1633046400"
+	p.ModifiedBy = "admin"
+	p.ModifiedTime = "1633050000"
+	p.VersionProfileName = "Default"
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+var observation = v1alpha1.Observation{
+	ID:                 id,
+	CreationTime:       "1633046400",
+	ModifiedBy:         "admin",
+	ModifiedTime:       "1633050000",
+	VersionProfileName: "Default",
+}
+
+func TestConnect(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	type want struct {
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceEdgeGroup": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNotServiceEdgeGroup)},
+		},
+		"NoProviderConfigRef": {
+			mg:   serviceEdgeGroup(),
+			want: want{err: errors.New("no providerConfigRef is given")},
+		},
+		"Success": {
+			mg: func() resource.Managed {
+				cr := serviceEdgeGroup()
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: zpafake.ProviderConfigName})
+				return cr
+			}(),
+			want: want{called: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			c := &connector{
+				kube: srv.Kube(),
+				newClientFn: func(transport runtime.ClientTransport, formats strfmt.Registry) serviceedgegroup.ClientService {
+					called = true
+					return serviceedgegroup.New(transport, formats)
+				},
+			}
+
+			_, err := c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nc.Connect(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nc.Connect(...): -want newClientFn called, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	drift := func(f func(*serviceedgegroup.ServiceEdgeGroup)) func(m *mockseg.MockClientService) {
+		return func(m *mockseg.MockClientService) {
+			m.EXPECT().GetServiceEdgeGroup(getParams()).Return(payload(f), nil)
+		}
+	}
+	observed := serviceEdgeGroup(withExternalName(id), withSpec(params()), withConditions(xpv1.Available()), withObservation(observation))
+	drifted := want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true}}
+
+	cases := map[string]struct {
+		mock func(m *mockseg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceEdgeGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServiceEdgeGroup)},
+		},
+		"NoExternalName": {
+			mg:   serviceEdgeGroup(withSpec(params())),
+			want: want{cr: serviceEdgeGroup(withSpec(params()))},
+		},
+		"NotFound": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().GetServiceEdgeGroup(getParams()).Return(nil, errNotFound)
+			},
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: serviceEdgeGroup(withExternalName(id), withSpec(params()))},
+		},
+		"GetFailed": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().GetServiceEdgeGroup(getParams()).Return(nil, errBoom)
+			},
+			mg: serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: want{
+				cr:  serviceEdgeGroup(withExternalName(id), withSpec(params())),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"UpToDate": {
+			mock: drift(func(*serviceedgegroup.ServiceEdgeGroup) {}),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialize": {
+			mock: drift(func(*serviceedgegroup.ServiceEdgeGroup) {}),
+			mg: serviceEdgeGroup(withExternalName(id), withSpec(params(func(p *v1alpha1.ServiceEdgeGroupParameters) {
+				p.Enabled = nil
+				p.OverrideVersionProfile = nil
+				p.CityCountry = ""
+				p.CountryCode = ""
+				p.VersionProfileID = ""
+				p.UpgradeDay = ""
+				p.UpgradeTimeInSecs = ""
+				p.IsPublic = nil
+				p.TrustedNetworks = nil
+			}))),
+			want: want{cr: observed, obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"EnabledDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.Enabled = false }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"OverrideVersionProfileDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.OverrideVersionProfile = false }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"DescriptionDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.Description = "changed" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CityCountryDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.CityCountry = "Frankfurt, DE" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"CountryCodeDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.CountryCode = "DE" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LocationDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.Location = "Frankfurt, Germany" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LatitudeDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.Latitude = "50.1109221" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"LongitudeDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.Longitude = "8.6821267" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"VersionProfileIDDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.VersionProfileID = "2" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UpgradeDayDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.UpgradeDay = "MONDAY" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"UpgradeTimeInSecsDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.UpgradeTimeInSecs = "0" }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"IsPublicDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.IsPublic = serviceedgegroup.IsPublicTrue }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+		"TrustedNetworksDrift": {
+			mock: drift(func(p *serviceedgegroup.ServiceEdgeGroup) { p.TrustedNetworks = p.TrustedNetworks[:1] }),
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: drifted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockseg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		mock func(m *mockseg.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceEdgeGroup": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotServiceEdgeGroup)},
+		},
+		"Success": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().AddServiceEdgeGroup(&serviceedgegroup.AddServiceEdgeGroupParams{
+					Context:          context.Background(),
+					CustomerID:       customerID,
+					ServiceEdgeGroup: model(),
+				}).Return(&serviceedgegroup.ServiceEdgeGroup{ID: id}, nil)
+			},
+			mg: serviceEdgeGroup(withSpec(params())),
+			want: want{
+				cr:  serviceEdgeGroup(withSpec(params()), withExternalName(id)),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().AddServiceEdgeGroup(gomock.Any()).Return(nil, errBoom)
+			},
+			mg: serviceEdgeGroup(withSpec(params())),
+			want: want{
+				cr:  serviceEdgeGroup(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockseg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("\ne.Create(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\ne.Create(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockseg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceEdgeGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServiceEdgeGroup),
+		},
+		"Success": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().UpdateServiceEdgeGroup(&serviceedgegroup.UpdateServiceEdgeGroupParams{
+					Context:            context.Background(),
+					CustomerID:         customerID,
+					ServiceEdgeGroupID: id,
+					ServiceEdgeGroup:   model(),
+				}).Return(nil)
+			},
+			mg: serviceEdgeGroup(withExternalName(id), withSpec(params())),
+		},
+		"UpdateFailed": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().UpdateServiceEdgeGroup(gomock.Any()).Return(errBoom)
+			},
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockseg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mock func(m *mockseg.MockClientService)
+		mg   resource.Managed
+		want error
+	}{
+		"NotServiceEdgeGroup": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotServiceEdgeGroup),
+		},
+		"NoExternalName": {
+			mg:   serviceEdgeGroup(withSpec(params())),
+			want: errors.New(errNotServiceEdgeGroup),
+		},
+		"Success": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().DeleteServiceEdgeGroup(&serviceedgegroup.DeleteServiceEdgeGroupParams{
+					Context:            context.Background(),
+					CustomerID:         customerID,
+					ServiceEdgeGroupID: id,
+				}).Return(nil)
+			},
+			mg: serviceEdgeGroup(withExternalName(id), withSpec(params())),
+		},
+		"AlreadyDeleted": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().DeleteServiceEdgeGroup(gomock.Any()).Return(errNotFound)
+			},
+			mg: serviceEdgeGroup(withExternalName(id), withSpec(params())),
+		},
+		"DeleteFailed": {
+			mock: func(m *mockseg.MockClientService) {
+				m.EXPECT().DeleteServiceEdgeGroup(gomock.Any()).Return(errBoom)
+			},
+			mg:   serviceEdgeGroup(withExternalName(id), withSpec(params())),
+			want: errors.Wrap(errBoom, errDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockseg.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+			e := &external{client: m}
+
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func getParams() *serviceedgegroup.GetServiceEdgeGroupParams {
+	return &serviceedgegroup.GetServiceEdgeGroupParams{
+		Context:            context.Background(),
+		CustomerID:         customerID,
+		ServiceEdgeGroupID: id,
+	}
+}
+
+// model returns the ServiceEdgeGroup that is sent to the ZPA API for the
+// parameters returned by params.
+func model() *serviceedgegroup.ServiceEdgeGroup {
+	return &serviceedgegroup.ServiceEdgeGroup{
+		Name:                   "example",
+		Enabled:                true,
+		Description:            "example",
+		CityCountry:            "San Jose, US",
+		CountryCode:            "US",
+		Location:               "San Jose, CA, USA",
+		Latitude:               "37.3382082",
+		Longitude:              "-121.8863286",
+		VersionProfileID:       "0",
+		OverrideVersionProfile: true,
+		UpgradeDay:             "SUNDAY",
+		UpgradeTimeInSecs:      "66600",
+		IsPublic:               serviceedgegroup.IsPublicFalse,
+		TrustedNetworks:        []serviceedgegroup.TrustedNetwork{{ID: "72058304855015480"}, {ID: "72058304855015481"}},
+	}
+}
+
+func TestExternalLifecycle(t *testing.T) {
+	srv := zpafake.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	e := &external{client: serviceedgegroup.New(srv.Transport(), strfmt.Default), kube: &test.MockClient{}}
+
+	cr := &v1alpha1.ServiceEdgeGroup{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.ServiceEdgeGroupParameters{
+		CustomerID:  customerID,
+		Description: "example service edge group",
+		Enabled:     zpaclient.Bool(true),
+		Location:    "San Jose, CA, USA",
+		Latitude:    "37.3382082",
+		Longitude:   "-121.8863286",
+	}
+
+	cre, err := e.Create(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("e.Create(...): -want, +got:\n%s\n", diff)
+	}
+	externalName := meta.GetExternalName(cr)
+	if got, ok := srv.Get(customerID, "serviceEdgeGroup", externalName); !ok || got["name"] != "example" {
+		t.Errorf("e.Create(...): want service edge group %q to be created, got %v", externalName, got)
+	}
+
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(zpaclient.Bool(false), cr.Spec.ForProvider.OverrideVersionProfile); diff != "" {
+		t.Errorf("e.Observe(...): -want late initialized overrideVersionProfile, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(externalName, cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Observe(...): -want observed ID, +got:\n%s\n", diff)
+	}
+
+	cr.Spec.ForProvider.UpgradeDay = "SUNDAY"
+	if obs, err := e.Observe(ctx, cr); err != nil || obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want drift to be detected, got %+v, %v", obs, err)
+	}
+
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if obs, err := e.Observe(ctx, cr); err != nil || !obs.ResourceUpToDate {
+		t.Errorf("e.Observe(...): want service edge group to be up to date after update, got %+v, %v", obs, err)
+	}
+	if got, _ := srv.Get(customerID, "serviceEdgeGroup", externalName); got["upgradeDay"] != "SUNDAY" {
+		t.Errorf("e.Update(...): want upgradeDay to be updated, got %v", got)
+	}
+
+	if err := e.Delete(ctx, cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	obs, err = e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{}, obs); diff != "" {
+		t.Errorf("e.Observe(...): -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timeoutpolicyrule
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/timeoutpolicyrule/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/policyrule"
+)
+
+const (
+	errNotTimeoutPolicyRule = "managed resource is not a TimeoutPolicyRule custom resource"
+
+	// actionReauth is the only action of timeout policy rules.
+	actionReauth = "RE_AUTH"
+)
+
+// SetupTimeoutPolicyRule adds a controller that reconciles TimeoutPolicyRules.
+func SetupTimeoutPolicyRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TimeoutPolicyRuleKind)
+	logger := l.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.TimeoutPolicyRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TimeoutPolicyRuleGroupVersionKind),
+			managed.WithExternalConnecter(&policyrule.Connector{
+				Kube:        mgr.GetClient(),
+				NewClientFn: policyrule.New,
+				Logger:      logger,
+				Recorder:    recorder,
+				Kind:        v1alpha1.TimeoutPolicyRuleKind,
+				PolicyType:  policyrule.PolicyTypeTimeout,
+				NewRuleFn:   func(runtime.ClientTransport) policyrule.RuleFn { return newRule },
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(logger),
+			managed.WithRecorder(recorder)))
+}
+
+// rule adapts a TimeoutPolicyRule to the policyrule.External.
+type rule struct {
+	cr *v1alpha1.TimeoutPolicyRule
+}
+
+func newRule(mg resource.Managed) (policyrule.Rule, error) {
+	cr, ok := mg.(*v1alpha1.TimeoutPolicyRule)
+	if !ok {
+		return nil, errors.New(errNotTimeoutPolicyRule)
+	}
+	return &rule{cr: cr}, nil
+}
+
+func (r *rule) CustomerID() string {
+	return r.cr.Spec.ForProvider.CustomerID
+}
+
+func (r *rule) RuleOrder() *int32 {
+	return r.cr.Spec.ForProvider.RuleOrder
+}
+
+func (r *rule) ObservedRuleOrder() int32 {
+	return r.cr.Status.AtProvider.RuleOrder
+}
+
+func (r *rule) Generate(_ context.Context) (*models.PolicyRule, error) {
+	return generateTimeoutPolicyRule(r.cr), nil
+}
+
+func (r *rule) Observe(_ context.Context, obj *models.PolicyRule) (bool, bool, error) {
+	p := &r.cr.Spec.ForProvider
+	r.cr.Status.AtProvider = generateObservation(obj)
+	lateInitialized := policyrule.LateInitialize(&p.Operator, &p.RuleOrder, obj)
+
+	return isUpToDate(p, obj), lateInitialized, nil
+}
+
+// generateTimeoutPolicyRule generates the models.PolicyRule that is sent to
+// the ZPA API for the supplied TimeoutPolicyRule.
+func generateTimeoutPolicyRule(cr *v1alpha1.TimeoutPolicyRule) *models.PolicyRule {
+	p := cr.Spec.ForProvider
+
+	return &models.PolicyRule{
+		Name:              zpaclient.String(cr.Name),
+		Action:            actionReauth,
+		ReauthTimeout:     p.ReauthTimeout,
+		ReauthIdleTimeout: p.ReauthIdleTimeout,
+		Description:       p.Description,
+		CustomMsg:         p.CustomMsg,
+		Operator:          p.Operator,
+		Conditions:        policyrule.GenerateConditions(p.Conditions),
+	}
+}
+
+// generateObservation generates observation for the input object models.PolicyRule
+func generateObservation(obj *models.PolicyRule) v1alpha1.Observation {
+	return v1alpha1.Observation{
+		CreationTime: obj.CreationTime,
+		ID:           obj.ID,
+		ModifiedBy:   obj.ModifiedBy,
+		ModifiedTime: obj.ModifiedTime,
+		PolicySetID:  obj.PolicySetID,
+		Priority:     obj.Priority,
+		RuleOrder:    obj.RuleOrder,
+	}
+}
+
+// isUpToDate checks whether there is a change in any of the modifiable fields.
+func isUpToDate(cr *v1alpha1.TimeoutPolicyRuleParameters, obj *models.PolicyRule) bool {
+	if cr.RuleOrder != nil && *cr.RuleOrder != obj.RuleOrder {
+		return false
+	}
+
+	if cr.ReauthTimeout != obj.ReauthTimeout || cr.ReauthIdleTimeout != obj.ReauthIdleTimeout {
+		return false
+	}
+
+	if !policyrule.IsEqualConditions(cr.Conditions, obj.Conditions) {
+		return false
+	}
+
+	for _, f := range []struct{ want, got string }{
+		{cr.Description, obj.Description},
+		{cr.CustomMsg, obj.CustomMsg},
+		{cr.Operator, obj.Operator},
+	} {
+		if f.want != f.got {
+			return false
+		}
+	}
+
+	return true
+}
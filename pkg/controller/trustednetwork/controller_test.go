@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trustednetwork
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/haarchri/zpa-go-client/pkg/models"
+
+	v1alpha1 "github.com/crossplane-contrib/provider-zpa/apis/trustednetwork/v1alpha1"
+	zpaclient "github.com/crossplane-contrib/provider-zpa/pkg/client"
+	"github.com/crossplane-contrib/provider-zpa/pkg/client/lookup"
+	mocklookup "github.com/crossplane-contrib/provider-zpa/pkg/client/mock/lookup"
+)
+
+const (
+	customerID         = "72058304855015424"
+	id                 = "72058304855015574"
+	trustedNetworkName = "Corp-Trusted-Networks"
+	networkID          = "869f3ef4-0b3b-4c4f-b4c5-4f6a5a6e1e2d"
+)
+
+type trustedNetworkModifier func(*v1alpha1.TrustedNetwork)
+
+func withObservation(o v1alpha1.Observation) trustedNetworkModifier {
+	return func(cr *v1alpha1.TrustedNetwork) { cr.Status.AtProvider = o }
+}
+
+func trustedNetwork(m ...trustedNetworkModifier) *v1alpha1.TrustedNetwork {
+	cr := &v1alpha1.TrustedNetwork{}
+	cr.SetName("example")
+	cr.Spec.ForProvider = v1alpha1.TrustedNetworkParameters{CustomerID: customerID, Name: trustedNetworkName}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func payload() *models.TrustedNetwork {
+	return &models.TrustedNetwork{
+		ID:           id,
+		Name:         zpaclient.String(trustedNetworkName),
+		NetworkID:    networkID,
+		ZscalerCloud: "zscalerthree",
+		CreationTime: "1633046400",
+		ModifiedBy:   "admin",
+		ModifiedTime: "1633050000",
+	}
+}
+
+var observation = v1alpha1.Observation{
+	ID:           id,
+	CreationTime: "1633046400",
+	ModifiedBy:   "admin",
+	ModifiedTime: "1633050000",
+	NetworkID:    networkID,
+	ZscalerCloud: "zscalerthree",
+}
+
+func TestLookupTrustedNetwork(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		id  string
+		err error
+	}
+
+	getParams := &lookup.GetTrustedNetworkByNameParams{
+		Context:    context.Background(),
+		CustomerID: customerID,
+		Name:       trustedNetworkName,
+	}
+
+	cases := map[string]struct {
+		mock func(m *mocklookup.MockClientService)
+		mg   resource.Managed
+		want want
+	}{
+		"NotTrustedNetwork": {
+			mg:   &fake.Managed{},
+			want: want{cr: &fake.Managed{}, err: errors.New(errNotTrustedNetwork)},
+		},
+		"Found": {
+			mock: func(m *mocklookup.MockClientService) {
+				m.EXPECT().GetTrustedNetworkByName(getParams).Return(payload(), nil)
+			},
+			mg:   trustedNetwork(),
+			want: want{cr: trustedNetwork(withObservation(observation)), id: id},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocklookup.NewMockClientService(ctrl)
+			if tc.mock != nil {
+				tc.mock(m)
+			}
+
+			id, err := lookupTrustedNetwork(context.Background(), m, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nlookupTrustedNetwork(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\nlookupTrustedNetwork(...): -want ID, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.mg); diff != "" {
+				t.Errorf("\nlookupTrustedNetwork(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
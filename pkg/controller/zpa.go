@@ -22,11 +22,32 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	accessPolicyRule "github.com/crossplane-contrib/provider-zpa/pkg/controller/accesspolicyrule"
+	appConnector "github.com/crossplane-contrib/provider-zpa/pkg/controller/appconnector"
+	appConnectorGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/appconnectorgroup"
 	applicationSegment "github.com/crossplane-contrib/provider-zpa/pkg/controller/applicationsegment"
+	baCertificate "github.com/crossplane-contrib/provider-zpa/pkg/controller/bacertificate"
+	clientForwardingPolicyRule "github.com/crossplane-contrib/provider-zpa/pkg/controller/clientforwardingpolicyrule"
 	"github.com/crossplane-contrib/provider-zpa/pkg/controller/config"
+	enrollmentCert "github.com/crossplane-contrib/provider-zpa/pkg/controller/enrollmentcert"
+	idp "github.com/crossplane-contrib/provider-zpa/pkg/controller/idp"
+	inspectionCustomControl "github.com/crossplane-contrib/provider-zpa/pkg/controller/inspectioncustomcontrol"
+	inspectionPolicyRule "github.com/crossplane-contrib/provider-zpa/pkg/controller/inspectionpolicyrule"
+	inspectionProfile "github.com/crossplane-contrib/provider-zpa/pkg/controller/inspectionprofile"
+	isolationPolicyRule "github.com/crossplane-contrib/provider-zpa/pkg/controller/isolationpolicyrule"
+	lssConfig "github.com/crossplane-contrib/provider-zpa/pkg/controller/lssconfig"
+	machineGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/machinegroup"
+	postureProfile "github.com/crossplane-contrib/provider-zpa/pkg/controller/postureprofile"
+	provisioningKey "github.com/crossplane-contrib/provider-zpa/pkg/controller/provisioningkey"
+	samlAttribute "github.com/crossplane-contrib/provider-zpa/pkg/controller/samlattribute"
+	scimGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/scimgroup"
 	segmentGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/segmentgroup"
 	server "github.com/crossplane-contrib/provider-zpa/pkg/controller/server"
 	serverGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/servergroup"
+	serviceEdge "github.com/crossplane-contrib/provider-zpa/pkg/controller/serviceedge"
+	serviceEdgeGroup "github.com/crossplane-contrib/provider-zpa/pkg/controller/serviceedgegroup"
+	timeoutPolicyRule "github.com/crossplane-contrib/provider-zpa/pkg/controller/timeoutpolicyrule"
+	trustedNetwork "github.com/crossplane-contrib/provider-zpa/pkg/controller/trustednetwork"
 )
 
 // Setup creates all Cluster API controllers with the supplied logger and adds
@@ -38,6 +59,27 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 		segmentGroup.SetupSegmentGroup,
 		server.SetupServer,
 		serverGroup.SetupServerGroup,
+		appConnectorGroup.SetupAppConnectorGroup,
+		appConnector.SetupAppConnector,
+		provisioningKey.SetupProvisioningKey,
+		accessPolicyRule.SetupAccessPolicyRule,
+		timeoutPolicyRule.SetupTimeoutPolicyRule,
+		clientForwardingPolicyRule.SetupClientForwardingPolicyRule,
+		serviceEdgeGroup.SetupServiceEdgeGroup,
+		serviceEdge.SetupServiceEdge,
+		idp.SetupIdp,
+		samlAttribute.SetupSAMLAttribute,
+		scimGroup.SetupSCIMGroup,
+		postureProfile.SetupPostureProfile,
+		trustedNetwork.SetupTrustedNetwork,
+		baCertificate.SetupBACertificate,
+		inspectionCustomControl.SetupInspectionCustomControl,
+		inspectionProfile.SetupInspectionProfile,
+		inspectionPolicyRule.SetupInspectionPolicyRule,
+		isolationPolicyRule.SetupIsolationPolicyRule,
+		lssConfig.SetupLSSConfig,
+		machineGroup.SetupMachineGroup,
+		enrollmentCert.SetupEnrollmentCert,
 	} {
 		if err := setup(mgr, l, rl); err != nil {
 			return err